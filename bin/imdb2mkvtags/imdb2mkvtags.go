@@ -11,11 +11,11 @@ import (
 	"os"
 )
 
-func write(file *os.File, data *tags.Movie) {
+func write(file *os.File, data *tags.Movie, mode tags.LanguageMode) {
 	if file != os.Stdout {
 		defer file.Close()
 	}
-	if err := tags.WriteTags(file, data.WriteTag); err != nil {
+	if err := tags.WriteTags(file, mode, data.WriteTag); err != nil {
 		global.Log.Die(fmt.Errorf("Error writing output: %s", err))
 	}
 }
@@ -54,5 +54,5 @@ func main() {
 	} else {
 		file = os.Stdout
 	}
-	write(file, movie)
+	write(file, movie, flags.TagLang)
 }
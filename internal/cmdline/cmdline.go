@@ -4,8 +4,10 @@ package cmdline
 
 import (
 	"flag"
+	"fmt"
 	"github.com/jwdev42/imdb2mkvtags/internal/global"
 	"github.com/jwdev42/imdb2mkvtags/internal/lcconv"
+	"github.com/jwdev42/imdb2mkvtags/internal/tags"
 	"github.com/jwdev42/logger"
 	"strings"
 )
@@ -19,19 +21,21 @@ type Flags struct {
 	Out       *string //output file
 	rawLang   *string //language-country combination(s)
 	Lang      []*lcconv.LngCntry
-	UserAgent *string  //Set custom user agent
-	Opts      *string  //options for the scraper
-	Tail      []string //non-processed args
+	UserAgent *string           //Set custom user agent
+	Opts      *string           //options for the scraper
+	TagLang   tags.LanguageMode //language elements of multilingual tags
+	Tail      []string          //non-processed args
 }
 
 func Parse() (*Flags, error) {
 	f := &Flags{Loglevel: logger.LevelFlag(global.DefaultLoglevel)}
 	f.LegalInfo = flag.Bool("print-legal-info", false, "Print legal information and exit.")
 	f.Out = flag.String("o", "", "Sets the output file.")
-	f.rawLang = flag.String("lang", "", "Sets the preferred language(s) for http requests. Multiple languages are separated by a colon.")
+	f.rawLang = flag.String("lang", "", "Sets the preferred language(s) for http requests. Multiple languages are separated by a colon, each may carry a weight like \"de-DE;q=0.8\".")
 	f.UserAgent = flag.String("user-agent", flagDefaultUserAgent, "Set the HTTP client's user agent to a custom value")
 	f.Opts = flag.String("opts", "", "Scraper-specific options, separated by a colon.")
 	flag.Var(&f.Loglevel, "loglevel", "set the logging verbosity.")
+	flag.Var(&f.TagLang, "tag-language", "Language elements of multilingual tags: \"ietf\" (TagLanguageIETF), \"legacy\" (TagLanguage) or \"both\".")
 	flag.Parse()
 	if err := f.parseLang(); err != nil {
 		return nil, err
@@ -49,12 +53,16 @@ func (r *Flags) parseLang() error {
 	rawLangs := strings.Split(*r.rawLang, ":")
 	langs := make([]*lcconv.LngCntry, len(rawLangs))
 	for i, rawLang := range rawLangs {
-		lang, err := lcconv.NewLngCntry(rawLang)
+		lang, err := lcconv.NewWeightedLngCntry(rawLang)
 		if err != nil {
 			return err
 		}
 		langs[i] = lang
 	}
+	// The first language is the preferred one, thus weights must not increase
+	if err := lcconv.CheckWeights(langs...); err != nil {
+		return fmt.Errorf("Languages must be ordered by descending weight: %s", err)
+	}
 	r.Lang = langs
 	return nil
 }
@@ -48,7 +48,7 @@ func Pick(rawurl string) (Controller, error) {
 	case "epsteindidntkillhimself.com":
 		global.Log.Die("Epstein didn’t kill himself")
 	}
-	return nil, fmt.Errorf("Scraping host %q is not supported")
+	return nil, fmt.Errorf("Scraping host %q is not supported", u.Host)
 }
 
 func validateUrlScheme(scheme string) error {
@@ -62,5 +62,4 @@ func validateUrlScheme(scheme string) error {
 	default:
 		return fmt.Errorf("Url scheme \"%s\" not supported", scheme)
 	}
-	panic("you're not supposed to be here")
 }
@@ -0,0 +1,178 @@
+//This file is part of imdb2mkvtags ©2026 Jörg Walter
+
+// Converts dates as displayed by IMDB into the ISO 8601 forms used by matroska tags.
+// Month names are understood in all languages of IMDB's localized websites.
+package date
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+var regexpISO = regexp.MustCompile("^(\\d{4})-(\\d{1,2})(?:-(\\d{1,2}))?$")
+var regexpDotted = regexp.MustCompile("^(\\d{1,2})\\.(\\d{1,2})\\.(\\d{4})$")
+var regexpParenthesized = regexp.MustCompile("\\([^)]*\\)")
+var regexpNumber = regexp.MustCompile("^(\\d+)(?:st|nd|rd|th|er|e|º|ª)?$")
+
+// Month names by language, January first. If abbreviate is set,
+// the first three and four letters of each name are accepted as abbreviations.
+var monthNames = []struct {
+	names      [12]string
+	abbreviate bool
+}{
+	{[12]string{"january", "february", "march", "april", "may", "june", "july", "august", "september", "october", "november", "december"}, true},
+	{[12]string{"januar", "februar", "märz", "april", "mai", "juni", "juli", "august", "september", "oktober", "november", "dezember"}, true},
+	{[12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"}, true},
+	{[12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"}, true},
+	{[12]string{"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"}, true},
+	{[12]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho", "agosto", "setembro", "outubro", "novembro", "dezembro"}, true},
+	{[12]string{"जनवरी", "फ़रवरी", "मार्च", "अप्रैल", "मई", "जून", "जुलाई", "अगस्त", "सितंबर", "अक्टूबर", "नवंबर", "दिसंबर"}, false},
+}
+
+// Spellings and abbreviations that are not covered by monthNames.
+var monthVariants = map[string]time.Month{
+	"jänner":   time.January,
+	"mrz":      time.March,
+	"fevrier":  time.February,
+	"aout":     time.August,
+	"decembre": time.December,
+	"marco":    time.March,
+	"फरवरी":    time.February,
+	"सितम्बर":  time.September,
+	"नवम्बर":   time.November,
+	"दिसम्बर":  time.December,
+}
+
+// Words that may appear between the parts of a date, e.g. "8 de junio de 1983".
+var fillers = []string{"de", "del", "di", "of", "the"}
+
+var months = buildMonths()
+
+// Maps all month names, abbreviations and variants to their months.
+// Abbreviations that are ambiguous between languages are dropped.
+func buildMonths() map[string]time.Month {
+	m := make(map[string]time.Month)
+	ambiguous := make(map[string]bool)
+	for _, lang := range monthNames {
+		if !lang.abbreviate {
+			continue
+		}
+		for i, name := range lang.names {
+			runes := []rune(name)
+			for _, n := range []int{3, 4} {
+				if len(runes) <= n {
+					continue
+				}
+				abbr := string(runes[:n])
+				if month, ok := m[abbr]; ok && month != time.Month(i+1) {
+					ambiguous[abbr] = true
+				}
+				m[abbr] = time.Month(i + 1)
+			}
+		}
+	}
+	for abbr := range ambiguous {
+		delete(m, abbr)
+	}
+	for _, lang := range monthNames {
+		for i, name := range lang.names {
+			m[name] = time.Month(i + 1)
+		}
+	}
+	for name, month := range monthVariants {
+		m[name] = month
+	}
+	return m
+}
+
+// Parses text and returns the date as YYYY-MM-DD, YYYY-MM or YYYY, depending on the precision of text.
+// Besides ISO 8601 and DD.MM.YYYY, text may name the month in any supported language, e.g. "June 8, 1983",
+// "8. Juni 1983" or "8 de junio de 1983". Parenthesized annotations like "(United States)" are ignored.
+func ISO8601(text string) (string, error) {
+	normalized := strings.Join(strings.Fields(regexpParenthesized.ReplaceAllString(text, " ")), " ")
+	var year, month, day int
+	if matches := regexpISO.FindStringSubmatch(normalized); matches != nil {
+		year, month, day = atoi(matches[1]), atoi(matches[2]), atoi(matches[3])
+		if month < 1 {
+			return "", fmt.Errorf("Invalid date: %q", text)
+		}
+	} else if matches := regexpDotted.FindStringSubmatch(normalized); matches != nil {
+		year, month, day = atoi(matches[3]), atoi(matches[2]), atoi(matches[1])
+		if month < 1 || day < 1 {
+			return "", fmt.Errorf("Invalid date: %q", text)
+		}
+	} else {
+		var err error
+		if year, month, day, err = parseWords(normalized); err != nil {
+			return "", fmt.Errorf("%s: %q", err, text)
+		}
+	}
+	return format(year, month, day, text)
+}
+
+// Parses a date consisting of a year, an optional month name and an optional day in any order.
+// Unset parts are returned as 0.
+func parseWords(text string) (year, month, day int, err error) {
+	tokens := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsMark(r)
+	})
+	for _, token := range tokens {
+		if m, ok := months[token]; ok {
+			if month != 0 {
+				return 0, 0, 0, errors.New("Multiple months in date")
+			}
+			month = int(m)
+			continue
+		}
+		if matches := regexpNumber.FindStringSubmatch(token); matches != nil {
+			switch n := atoi(matches[1]); {
+			case len(matches[1]) == 4 && year == 0:
+				year = n
+			case len(matches[1]) <= 2 && day == 0 && n > 0:
+				day = n
+			default:
+				return 0, 0, 0, fmt.Errorf("Unexpected number %q in date", token)
+			}
+			continue
+		}
+		if !slices.Contains(fillers, token) {
+			return 0, 0, 0, errors.New("Unknown date format")
+		}
+	}
+	switch {
+	case year == 0:
+		return 0, 0, 0, errors.New("No year in date")
+	case day != 0 && month == 0:
+		return 0, 0, 0, errors.New("No month in date")
+	}
+	return year, month, day, nil
+}
+
+// Formats the date at the precision of the set parts, text is only used for error messages.
+func format(year, month, day int, text string) (string, error) {
+	switch {
+	case month == 0:
+		return fmt.Sprintf("%04d", year), nil
+	case month > 12:
+		return "", fmt.Errorf("Invalid month in date %q", text)
+	case day == 0:
+		return fmt.Sprintf("%04d-%02d", year, month), nil
+	}
+	t := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if t.Day() != day {
+		return "", fmt.Errorf("Invalid day in date %q", text)
+	}
+	return t.Format("2006-01-02"), nil
+}
+
+// Returns the value of a string of digits, 0 if s is empty.
+func atoi(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
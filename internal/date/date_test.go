@@ -0,0 +1,49 @@
+//This file is part of imdb2mkvtags ©2026 Jörg Walter
+
+package date
+
+import (
+	"testing"
+)
+
+func TestISO8601(t *testing.T) {
+	dates := map[string]string{
+		"1983":                         "1983",
+		"1983-06":                      "1983-06",
+		"1983-06-08":                   "1983-06-08",
+		"08.06.1983":                   "1983-06-08",
+		"June 8, 1983":                 "1983-06-08",
+		"Jun 8, 1983":                  "1983-06-08",
+		"June 8th, 1983":               "1983-06-08",
+		"8 June 1983":                  "1983-06-08",
+		"June 1983":                    "1983-06",
+		"June 8, 1983 (United States)": "1983-06-08",
+		"8. Juni 1983":                 "1983-06-08",
+		"8. Jänner 1983":               "1983-01-08",
+		"13. Dez. 1982":                "1982-12-13",
+		"1er juin 1983":                "1983-06-01",
+		"8 févr. 1983":                 "1983-02-08",
+		"8 juil. 1983":                 "1983-07-08",
+		"8 de junio de 1983":           "1983-06-08",
+		"8 de junho de 1983":           "1983-06-08",
+		"8 giugno 1983":                "1983-06-08",
+		"8 जून 1983":                   "1983-06-08",
+		"  June   1983 ":               "1983-06",
+	}
+	for input, expected := range dates {
+		res, err := ISO8601(input)
+		if err != nil {
+			t.Errorf("ISO8601(%q): %s", input, err)
+		} else if res != expected {
+			t.Errorf("ISO8601(%q): Expected %q, got %q", input, expected, res)
+		}
+	}
+}
+
+func TestISO8601Invalid(t *testing.T) {
+	for _, input := range []string{"", "June", "8 June", "February 30, 1983", "1983-13", "31.04.1983", "06/08/1983", "Coming soon", "June 8, 1983 July"} {
+		if res, err := ISO8601(input); err == nil {
+			t.Errorf("ISO8601(%q): Expected error, got %q", input, res)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+//This file is part of imdb2mkvtags ©2026 Jörg Walter
+
+// Package genre translates IMDB's genre vocabulary and remaps genres by a user-supplied mapping.
+package genre
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ISO-639-1 codes of the languages in translations, in the order of their columns.
+var languages = [...]string{"de", "es", "fr", "it", "pt", "hi"}
+
+// Maps IMDB's english genre names to their translations.
+var translations = map[string][len(languages)]string{
+	"Action":      {"Action", "Acción", "Action", "Azione", "Ação", "एक्शन"},
+	"Adult":       {"Erotik", "Adulto", "Adulte", "Per adulti", "Adulto", "वयस्क"},
+	"Adventure":   {"Abenteuer", "Aventura", "Aventure", "Avventura", "Aventura", "साहसिक"},
+	"Animation":   {"Animation", "Animación", "Animation", "Animazione", "Animação", "एनिमेशन"},
+	"Biography":   {"Biografie", "Biografía", "Biographie", "Biografico", "Biografia", "जीवनी"},
+	"Comedy":      {"Komödie", "Comedia", "Comédie", "Commedia", "Comédia", "कॉमेडी"},
+	"Crime":       {"Krimi", "Crimen", "Policier", "Poliziesco", "Crime", "अपराध"},
+	"Documentary": {"Dokumentarfilm", "Documental", "Documentaire", "Documentario", "Documentário", "डॉक्यूमेंट्री"},
+	"Drama":       {"Drama", "Drama", "Drame", "Drammatico", "Drama", "ड्रामा"},
+	"Family":      {"Familie", "Familia", "Famille", "Famiglia", "Família", "पारिवारिक"},
+	"Fantasy":     {"Fantasy", "Fantasía", "Fantastique", "Fantasy", "Fantasia", "फ़ैंटेसी"},
+	"Film-Noir":   {"Film noir", "Cine negro", "Film noir", "Noir", "Filme noir", "फ़िल्म-नोयर"},
+	"Game-Show":   {"Spielshow", "Concurso", "Jeu télévisé", "Quiz", "Game show", "गेम-शो"},
+	"History":     {"Historie", "Historia", "Histoire", "Storico", "História", "इतिहास"},
+	"Horror":      {"Horror", "Terror", "Horreur", "Horror", "Terror", "हॉरर"},
+	"Music":       {"Musik", "Música", "Musique", "Musica", "Música", "संगीत"},
+	"Musical":     {"Musical", "Musical", "Comédie musicale", "Musical", "Musical", "म्यूज़िकल"},
+	"Mystery":     {"Mystery", "Misterio", "Mystère", "Mistero", "Mistério", "रहस्य"},
+	"News":        {"Nachrichten", "Noticias", "Actualités", "Notiziario", "Notícias", "समाचार"},
+	"Reality-TV":  {"Reality-TV", "Reality show", "Téléréalité", "Reality", "Reality show", "रियलिटी टीवी"},
+	"Romance":     {"Liebesfilm", "Romance", "Romance", "Sentimentale", "Romance", "रोमांस"},
+	"Sci-Fi":      {"Science-Fiction", "Ciencia ficción", "Science-fiction", "Fantascienza", "Ficção científica", "साइंस फ़िक्शन"},
+	"Short":       {"Kurzfilm", "Cortometraje", "Court métrage", "Cortometraggio", "Curta-metragem", "लघु फ़िल्म"},
+	"Sport":       {"Sport", "Deporte", "Sport", "Sport", "Esporte", "खेल"},
+	"Talk-Show":   {"Talkshow", "Programa de entrevistas", "Talk-show", "Talk show", "Talk show", "टॉक शो"},
+	"Thriller":    {"Thriller", "Suspenso", "Thriller", "Thriller", "Suspense", "थ्रिलर"},
+	"War":         {"Krieg", "Bélica", "Guerre", "Guerra", "Guerra", "युद्ध"},
+	"Western":     {"Western", "Western", "Western", "Western", "Faroeste", "वेस्टर्न"},
+}
+
+// Maps the lowercase english names and translations to the english names.
+var index = buildIndex()
+
+func buildIndex() map[string]string {
+	index := make(map[string]string, len(translations)*(len(languages)+1))
+	for name, localized := range translations {
+		index[strings.ToLower(name)] = name
+		for _, text := range localized {
+			index[strings.ToLower(text)] = name
+		}
+	}
+	return index
+}
+
+// Returns IMDB's english name of genre, which may be given case-insensitively in any supported language.
+// Returns false if genre is not part of IMDB's genre vocabulary.
+func Canonical(genre string) (string, bool) {
+	name, ok := index[strings.ToLower(strings.TrimSpace(genre))]
+	return name, ok
+}
+
+// Returns the name of genre in the language with the given ISO-639-1 code.
+// Returns false if genre or language are unknown.
+func Translate(genre, lang string) (string, bool) {
+	name, ok := Canonical(genre)
+	if !ok {
+		return "", false
+	}
+	if lang == "en" {
+		return name, true
+	}
+	for i, l := range languages {
+		if l == lang {
+			return translations[name][i], true
+		}
+	}
+	return "", false
+}
+
+// Maps genres to user-defined names. Known genres are keyed by their english name,
+// so that an entry applies to all of the genre's translations.
+type Mapping map[string]string
+
+// Reads a mapping with one "genre = name" entry per line. Empty lines and lines starting with "#" are ignored.
+// An empty name drops the genre.
+func ReadMapping(r io.Reader) (Mapping, error) {
+	m := make(Mapping)
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		entry := strings.TrimSpace(scanner.Text())
+		if entry == "" || strings.HasPrefix(entry, "#") {
+			continue
+		}
+		genre, name, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("Line %d: Missing \"=\"", line)
+		}
+		if strings.TrimSpace(genre) == "" {
+			return nil, fmt.Errorf("Line %d: Empty genre", line)
+		}
+		key := mappingKey(genre)
+		if _, ok := m[key]; ok {
+			return nil, fmt.Errorf("Line %d: Duplicate entry for genre %q", line, strings.TrimSpace(genre))
+		}
+		m[key] = strings.TrimSpace(name)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Returns the user-defined name of genre and true if the mapping has an entry for it.
+func (m Mapping) Map(genre string) (string, bool) {
+	name, ok := m[mappingKey(genre)]
+	return name, ok
+}
+
+func mappingKey(genre string) string {
+	if name, ok := Canonical(genre); ok {
+		return name
+	}
+	return strings.ToLower(strings.TrimSpace(genre))
+}
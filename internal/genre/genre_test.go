@@ -0,0 +1,82 @@
+//This file is part of imdb2mkvtags ©2026 Jörg Walter
+
+package genre
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTranslate(t *testing.T) {
+	tests := []struct {
+		genre, lang, expected string
+	}{
+		{"Sci-Fi", "de", "Science-Fiction"},
+		{"sci-fi", "fr", "Science-fiction"},
+		{"Komödie", "es", "Comedia"},
+		{"Comedia", "en", "Comedy"},
+		{" Drame ", "it", "Drammatico"},
+		{"Horror", "hi", "हॉरर"},
+	}
+	for _, test := range tests {
+		res, ok := Translate(test.genre, test.lang)
+		if !ok {
+			t.Errorf("Translate(%q, %q): No translation found", test.genre, test.lang)
+		} else if res != test.expected {
+			t.Errorf("Translate(%q, %q): Expected %q, got %q", test.genre, test.lang, test.expected, res)
+		}
+	}
+	if res, ok := Translate("Cyberpunk", "de"); ok {
+		t.Errorf("Translate(\"Cyberpunk\", \"de\"): Expected no translation, got %q", res)
+	}
+	if res, ok := Translate("Drama", "xx"); ok {
+		t.Errorf("Translate(\"Drama\", \"xx\"): Expected no translation, got %q", res)
+	}
+}
+
+// Every translation must lead back to its own genre.
+func TestTranslationsUnambiguous(t *testing.T) {
+	for name, localized := range translations {
+		for i, text := range localized {
+			if res, _ := Canonical(text); res != name {
+				t.Errorf("Translation %q (%s) of %q is also a translation of %q", text, languages[i], name, res)
+			}
+		}
+	}
+}
+
+func TestReadMapping(t *testing.T) {
+	const file = `# Media server genres
+Sci-Fi = Science Fiction
+Film-Noir = Crime
+
+Short =
+Cyberpunk = Science Fiction
+`
+	m, err := ReadMapping(strings.NewReader(file))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := map[string]string{
+		"Sci-Fi":          "Science Fiction",
+		"Science-Fiction": "Science Fiction",
+		"Film noir":       "Crime",
+		"Kurzfilm":        "",
+		"cyberpunk":       "Science Fiction",
+	}
+	for genre, expected := range tests {
+		if res, ok := m.Map(genre); !ok {
+			t.Errorf("Map(%q): No entry found", genre)
+		} else if res != expected {
+			t.Errorf("Map(%q): Expected %q, got %q", genre, expected, res)
+		}
+	}
+	if res, ok := m.Map("Drama"); ok {
+		t.Errorf("Map(\"Drama\"): Expected no entry, got %q", res)
+	}
+	for _, file := range []string{"Drama", "= Drama", "Sci-Fi = A\nScience-Fiction = B"} {
+		if _, err := ReadMapping(strings.NewReader(file)); err == nil {
+			t.Errorf("ReadMapping(%q): Expected error", file)
+		}
+	}
+}
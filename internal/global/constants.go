@@ -4,3 +4,4 @@ package global
 
 const DelimControllerArgs = ":" //delimiter to separate controller-specific arguments.
 const DelimControllerKV = "="   //delimiter to separate arguments and values in controller-specific arguments.
+const DelimControllerList = "," //delimiter to separate list entries in values of controller-specific arguments.
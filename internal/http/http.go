@@ -3,15 +3,18 @@
 package http
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
 	"github.com/jwdev42/imdb2mkvtags/internal/lcconv"
 	"io"
+	"math"
 	"net/http"
-	"regexp"
+	"slices"
+	"strconv"
+	"strings"
 )
 
-var regexpLang = regexp.MustCompile("^[a-z]{2}(-[A-Z]{2})?$")
 var internalClient = new(http.Client) //Default client for this library.
 
 // Makes an HTTP request and writes the body to dest. If client is nil, the library's default client will be used.
@@ -51,20 +54,41 @@ func SetReqAccLang(req *http.Request, lang ...*lcconv.LngCntry) error {
 	if req.Header == nil {
 		req.Header = make(http.Header)
 	}
-	for i, v := range lang {
+	header, err := AcceptLanguage(lang...)
+	if err != nil {
+		return err
+	}
+	if header != "" {
+		req.Header.Set("Accept-Language", header)
+	}
+	return nil
+}
+
+// Generates the value of an "Accept-Language" header field like "de-DE,de;q=0.9,en-US;q=0.8,en;q=0.7".
+// Languages without an explicit weight get the previous language's weight reduced by 0.1, at least 0.1.
+// Each language with a region or script is followed by its base language if the base language is not listed.
+// The languages are ordered by descending weight.
+func AcceptLanguage(lang ...*lcconv.LngCntry) (string, error) {
+	for _, v := range lang {
 		if v == nil {
 			panic("argument \"lang\" cannot be nil")
 		}
 		if err := chkLang(v.HttpHeader()); err != nil {
-			return err
+			return "", err
 		}
-		if i == 0 {
-			req.Header.Set("Accept-Language", v.HttpHeader())
-		} else {
-			req.Header.Add("Accept-Language", v.HttpHeader())
+	}
+	entries := lcconv.EffectiveWeights(lang...)
+	slices.SortStableFunc(entries, func(a, b lcconv.WeightedTag) int {
+		return cmp.Compare(b.Quality, a.Quality)
+	})
+	fields := make([]string, len(entries))
+	for i, e := range entries {
+		fields[i] = e.Tag
+		if e.Quality < 1 {
+			fields[i] += ";q=" + strconv.FormatFloat(math.Round(e.Quality*1000)/1000, 'f', -1, 64)
 		}
 	}
-	return nil
+	return strings.Join(fields, ","), nil
 }
 
 // Makes an HTTP request to URL url, writes the answer's body to dest. If client is nil the library's default client will be used.
@@ -82,8 +106,10 @@ func GetBody(client *http.Client, userAgent, url string, dest io.Writer, lang ..
 	return Body(client, req, dest)
 }
 
+// Accepts canonical BCP 47 language tags only.
 func chkLang(s string) error {
-	if !regexpLang.MatchString(s) {
+	lng, err := lcconv.NewLngCntry(s)
+	if err != nil || lng.String() != s {
 		return errors.New("Malformed language string")
 	}
 	return nil
@@ -0,0 +1,53 @@
+//This file is part of imdb2mkvtags ©2026 Jörg Walter
+
+package http
+
+import (
+	"github.com/jwdev42/imdb2mkvtags/internal/lcconv"
+	"strings"
+	"testing"
+)
+
+func TestChkLang(t *testing.T) {
+	for _, s := range []string{"de", "de-DE", "zh-Hant-TW", "sr-Latn", "es-419", "gsw"} {
+		if err := chkLang(s); err != nil {
+			t.Errorf("chkLang(%q): %s", s, err)
+		}
+	}
+	for _, s := range []string{"", "de-de", "DE", "de-DE,en", "de-DE\r\nX-Injected: 1", "de DE"} {
+		if err := chkLang(s); err == nil {
+			t.Errorf("Expected an error when calling chkLang with input string %q", s)
+		}
+	}
+}
+
+func TestAcceptLanguage(t *testing.T) {
+	headers := map[string]string{
+		"de-DE:en-US":                "de-DE,de;q=0.9,en-US;q=0.8,en;q=0.7",
+		"de-DE;q=1:en-US;q=0.5":      "de-DE,de;q=0.9,en-US;q=0.5,en;q=0.4",
+		"de-DE:de:en":                "de-DE,de;q=0.9,en;q=0.8",
+		"fr-CA:en-US;q=0.05":         "fr-CA,fr;q=0.9,en-US;q=0.05,en;q=0.05",
+		"zh-Hant-TW":                 "zh-Hant-TW,zh;q=0.9",
+		"de-DE:de-DE":                "de-DE,de;q=0.9",
+		"en":                         "en",
+		"de-AT:de-CH:de-DE:en-GB:en": "de-AT,de;q=0.9,de-CH;q=0.8,de-DE;q=0.7,en-GB;q=0.6,en;q=0.5",
+		"de-DE:en;q=0.95":            "de-DE,en;q=0.95,de;q=0.9",
+	}
+	for input, expected := range headers {
+		raw := strings.Split(input, ":")
+		langs := make([]*lcconv.LngCntry, len(raw))
+		for i, s := range raw {
+			lang, err := lcconv.NewWeightedLngCntry(s)
+			if err != nil {
+				t.Fatalf("NewWeightedLngCntry(%q): %s", s, err)
+			}
+			langs[i] = lang
+		}
+		res, err := AcceptLanguage(langs...)
+		if err != nil {
+			t.Errorf("AcceptLanguage(%q): %s", input, err)
+		} else if res != expected {
+			t.Errorf("AcceptLanguage(%q): Expected \"%s\", got \"%s\"", input, expected, res)
+		}
+	}
+}
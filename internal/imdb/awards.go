@@ -0,0 +1,112 @@
+//This file is part of imdb2mkvtags ©2026 Jörg Walter
+
+package imdb
+
+import (
+	"errors"
+	"fmt"
+	"github.com/jwdev42/imdb2mkvtags/internal/global"
+	"github.com/jwdev42/imdb2mkvtags/internal/tags"
+	"github.com/jwdev42/rottensoup"
+	"golang.org/x/net/html"
+	"io"
+	"regexp"
+	"strings"
+)
+
+const awardSubSectionPrefix = "sub-section-"
+
+var matchAwardSubSection = regexp.MustCompile("^" + awardSubSectionPrefix + "ev")
+var regexpEventID = regexp.MustCompile("^ev\\d{7}$")
+var regexpAwardOutcome = regexp.MustCompile("^(\\d{4})\\s+(.+)$")
+var regexpAwardWinner = regexp.MustCompile("(?i)^(winner|gewinner|gagnant|ganador|vincitore|vencedor)")
+
+// Aliases for the IDs of well-known award events, used by option "award-events".
+var awardEventAliases = map[string]string{
+	"bafta":         "ev0000123",
+	"berlinale":     "ev0000091",
+	"cannes":        "ev0000147",
+	"emmys":         "ev0000223",
+	"golden-globes": "ev0000292",
+	"oscars":        "ev0000003",
+	"sundance":      "ev0000631",
+	"venice":        "ev0000681",
+}
+
+// represents "awards" pages https://www.imdb.com/title/$titleID/awards
+type Awards struct {
+	root *html.Node
+}
+
+func NewAwards(r io.Reader) (*Awards, error) {
+	root, err := html.Parse(r)
+	if err != nil {
+		return nil, err
+	}
+	return &Awards{
+		root: root,
+	}, nil
+}
+
+// Scrapes all awards and nominations. Each event has its own subsection whose test ID ends with the event ID.
+func (r *Awards) Awards() ([]*tags.Award, error) {
+	awards := make([]*tags.Award, 0, 20)
+	for _, section := range rottensoup.ElementsByAttrMatch(r.root, "", attrTestID, matchAwardSubSection) {
+		eventID := strings.TrimPrefix(rottensoup.AttrVal(section, "", attrTestID), awardSubSectionPrefix)
+		if !regexpEventID.MatchString(eventID) {
+			continue
+		}
+		event := eventID
+		if container := sectionByAnchor(r.root, eventID); container != nil {
+			if title := rottensoup.FirstElementByClassName(container, "ipc-title__text"); title != nil {
+				event = strings.TrimSpace(nodeText(title))
+			}
+		}
+		for i, item := range outerListItems(section) {
+			award, err := r.award(item, event, eventID)
+			if err != nil {
+				global.Log.Infof("Award %d of event %s: %s", i+1, eventID, err)
+				continue
+			}
+			awards = append(awards, award)
+		}
+	}
+	if len(awards) < 1 {
+		return nil, errors.New("No awards found")
+	}
+	return awards, nil
+}
+
+func (r *Awards) award(item *html.Node, event, eventID string) (*tags.Award, error) {
+	title := rottensoup.FirstElementByClassName(item, "ipc-metadata-list-summary-item__t")
+	if title == nil {
+		return nil, errors.New("No year and outcome found")
+	}
+	matches := regexpAwardOutcome.FindStringSubmatch(strings.TrimSpace(nodeText(title)))
+	if matches == nil {
+		return nil, fmt.Errorf("Malformed year and outcome %q", nodeText(title))
+	}
+	award := &tags.Award{
+		Event:   event,
+		EventID: tags.UniLingual(eventID),
+		Outcome: tags.UniLingual(matches[2]),
+		Year:    tags.UniLingual(matches[1]),
+	}
+	if name := rottensoup.FirstElementByClassName(item, "ipc-metadata-list-summary-item__tst"); name != nil {
+		award.Name = tags.UniLingual(strings.TrimSpace(nodeText(name)))
+	}
+	if category := rottensoup.FirstElementByClassName(item, "awardCategoryName"); category != nil {
+		award.Category = tags.UniLingual(strings.TrimSpace(nodeText(category)))
+	}
+	for _, link := range rottensoup.ElementsByAttrMatch(item, "", "href", matchNameLink) {
+		if name := tags.UniLingual(strings.TrimSpace(nodeText(link))); name != "" {
+			award.Recipients = mergeNames(award.Recipients, []tags.UniLingual{name})
+		}
+	}
+	return award, nil
+}
+
+// Returns true if the award's outcome denotes a win.
+func isAwardWin(award *tags.Award) bool {
+	return regexpAwardWinner.MatchString(string(award.Outcome))
+}
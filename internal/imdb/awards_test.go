@@ -0,0 +1,64 @@
+//This file is part of imdb2mkvtags ©2026 Jörg Walter
+
+package imdb
+
+import (
+	"github.com/jwdev42/imdb2mkvtags/internal/tags"
+	"reflect"
+	"testing"
+)
+
+func TestAwards(t *testing.T) {
+	page, err := NewAwards(openFixture(t, "awards.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []tags.Award{
+		{
+			Event:      "Academy Awards, USA",
+			Category:   "Best Art Direction-Set Decoration",
+			EventID:    "ev0000003",
+			Name:       "Oscar",
+			Outcome:    "Winner",
+			Recipients: []tags.UniLingual{"Anton Furst", "Peter Young"},
+			Year:       "1990",
+		},
+		{
+			Event:      "ev0000123",
+			Category:   "Best Make Up Artist",
+			EventID:    "ev0000123",
+			Name:       "BAFTA Film Award",
+			Outcome:    "Nominee",
+			Recipients: []tags.UniLingual{"Paul Engelen"},
+			Year:       "1990",
+		},
+	}
+	awards, err := page.Awards()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(awards) != len(expected) {
+		t.Fatalf("Awards: Expected %d awards, got %d", len(expected), len(awards))
+	}
+	for i, award := range awards {
+		if !reflect.DeepEqual(*award, expected[i]) {
+			t.Errorf("Awards[%d]: Expected %+v, got %+v", i, expected[i], *award)
+		}
+	}
+}
+
+func TestIsAwardWin(t *testing.T) {
+	tests := map[tags.UniLingual]bool{
+		"Winner":            true,
+		"Gewinner":          true,
+		"Winner (shared)":   true,
+		"Nominee":           false,
+		"Nominiert":         false,
+		"Honorable Mention": false,
+	}
+	for outcome, expected := range tests {
+		if res := isAwardWin(&tags.Award{Outcome: outcome}); res != expected {
+			t.Errorf("isAwardWin(%q): Expected %t, got %t", outcome, expected, res)
+		}
+	}
+}
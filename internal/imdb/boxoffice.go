@@ -0,0 +1,102 @@
+//This file is part of imdb2mkvtags ©2026 Jörg Walter
+
+package imdb
+
+import (
+	"errors"
+	"fmt"
+	"github.com/jwdev42/imdb2mkvtags/internal/tags"
+	"regexp"
+	"strings"
+)
+
+var regexpMoney = regexp.MustCompile("^\\s*([^\\d\\s]+)\\s?(\\d[\\d.,\\s]*)")
+var regexpMoneySuffix = regexp.MustCompile("^\\s*(\\d[\\d.,\\s]*?)\\s?([^\\d\\s(.,]+)")
+var regexpCurrencyCode = regexp.MustCompile("^[A-Z]{3}$")
+
+// Maps the currency symbols displayed by IMDB to ISO 4217 currency codes.
+var currencySymbols = map[string]string{
+	"$":   "USD",
+	"A$":  "AUD",
+	"CA$": "CAD",
+	"HK$": "HKD",
+	"NZ$": "NZD",
+	"R$":  "BRL",
+	"€":   "EUR",
+	"£":   "GBP",
+	"¥":   "JPY",
+	"₹":   "INR",
+	"₩":   "KRW",
+}
+
+// Maps the fields of tags.BoxOffice to the test IDs of their list items on the title page.
+var boxOfficeTestIDs = map[string]string{
+	"Budget":         "title-boxoffice-budget",
+	"GrossDomestic":  "title-boxoffice-grossdomestic",
+	"GrossWorldwide": "title-boxoffice-cumulativeworldwidegross",
+	"OpeningWeekend": "title-boxoffice-openingweekenddomestic",
+}
+
+// Scrapes the box office section.
+func (r *Title) BoxOffice() (*tags.BoxOffice, error) {
+	boxOffice := new(tags.BoxOffice)
+	for field, testID := range boxOfficeTestIDs {
+		boxOffice.SetFieldCallback(field, func() (*tags.Money, error) {
+			return r.money(testID)
+		})
+	}
+	if boxOffice.IsEmpty() {
+		return nil, errors.New("No box office data found")
+	}
+	return boxOffice, nil
+}
+
+func (r *Title) money(testID string) (*tags.Money, error) {
+	item, err := r.elementByTestID(testID)
+	if err != nil {
+		return nil, err
+	}
+	entries := listItemEntries(item)
+	if len(entries) < 1 {
+		return nil, fmt.Errorf("No amount found in element with attribute %s=\"%s\"", attrTestID, testID)
+	}
+	money, err := parseMoney(entries[0].Value)
+	if err != nil {
+		return nil, err
+	}
+	if money.Note == "" {
+		money.Note = tags.UniLingual(entries[0].Note)
+	}
+	return money, nil
+}
+
+// Parses an amount of money like "$15,000,000 (estimated)", "DEM 1.000.000" or "90.404.800 €".
+// The currency symbol is converted into an ISO 4217 currency code.
+func parseMoney(text string) (*tags.Money, error) {
+	matches := regexpMoney.FindStringSubmatch(text)
+	if matches == nil {
+		if matches = regexpMoneySuffix.FindStringSubmatch(text); matches == nil {
+			return nil, fmt.Errorf("Malformed amount %q", text)
+		}
+		matches[1], matches[2] = matches[2], matches[1]
+	}
+	currency, ok := currencySymbols[matches[1]]
+	if !ok {
+		if !regexpCurrencyCode.MatchString(matches[1]) {
+			return nil, fmt.Errorf("Unknown currency %q", matches[1])
+		}
+		currency = matches[1]
+	}
+	amount := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, matches[2])
+	money := &tags.Money{Amount: amount, Currency: tags.UniLingual(currency)}
+	if strings.Contains(text, "(") {
+		_, note, _ := strings.Cut(text, "(")
+		money.Note = tags.UniLingual(strings.TrimSuffix(strings.TrimSpace(note), ")"))
+	}
+	return money, nil
+}
@@ -0,0 +1,58 @@
+//This file is part of imdb2mkvtags ©2026 Jörg Walter
+
+package imdb
+
+import (
+	"github.com/jwdev42/imdb2mkvtags/internal/tags"
+	"testing"
+)
+
+func TestBoxOffice(t *testing.T) {
+	title, err := NewTitle(nil, openFixture(t, "title-boxoffice.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	boxOffice, err := title.BoxOffice()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := map[string]struct {
+		res, expected *tags.Money
+	}{
+		"Budget":         {boxOffice.Budget, &tags.Money{Amount: "35000000", Currency: "USD", Note: "estimated"}},
+		"GrossDomestic":  {boxOffice.GrossDomestic, &tags.Money{Amount: "251409241", Currency: "USD"}},
+		"GrossWorldwide": {boxOffice.GrossWorldwide, nil},
+		"OpeningWeekend": {boxOffice.OpeningWeekend, &tags.Money{Amount: "40489746", Currency: "USD", Note: "Jun 25, 1989"}},
+	}
+	for field, test := range tests {
+		if test.res == nil || test.expected == nil {
+			if test.res != test.expected {
+				t.Errorf("BoxOffice: Expected %s %v, got %v", field, test.expected, test.res)
+			}
+		} else if *test.res != *test.expected {
+			t.Errorf("BoxOffice: Expected %s %v, got %v", field, *test.expected, *test.res)
+		}
+	}
+}
+
+func TestParseMoney(t *testing.T) {
+	tests := map[string]tags.Money{
+		"$15,000,000 (estimated)": {Amount: "15000000", Currency: "USD", Note: "estimated"},
+		"DEM 1.000.000":           {Amount: "1000000", Currency: "DEM"},
+		"90.404.800 €":            {Amount: "90404800", Currency: "EUR"},
+		"CA$2,500,000":            {Amount: "2500000", Currency: "CAD"},
+		"₹ 50,00,00,000":          {Amount: "500000000", Currency: "INR"},
+	}
+	for text, expected := range tests {
+		if res, err := parseMoney(text); err != nil {
+			t.Errorf("parseMoney(%q): %s", text, err)
+		} else if *res != expected {
+			t.Errorf("parseMoney(%q): Expected %v, got %v", text, expected, *res)
+		}
+	}
+	for _, text := range []string{"", "unknown", "Xx 1,000", "1,000"} {
+		if res, err := parseMoney(text); err == nil {
+			t.Errorf("parseMoney(%q): Expected error, got %v", text, *res)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+//This file is part of imdb2mkvtags ©2026 Jörg Walter
+
+package imdb
+
+import (
+	"errors"
+	"fmt"
+	"github.com/biter777/countries"
+	"github.com/jwdev42/imdb2mkvtags/internal/global"
+	"github.com/jwdev42/imdb2mkvtags/internal/tags"
+	"github.com/jwdev42/rottensoup"
+	"golang.org/x/net/html"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var matchCompanyLink = regexp.MustCompile("company\\/co")
+var matchParenthesized = regexp.MustCompile("\\(([^()]+)\\)")
+
+// Describes a company type that can be selected by option "companies".
+type companyType struct {
+	field  string // Field of tags.Movie that receives the company names
+	testID string // Test ID of the company type's subsection on the companycredits page
+}
+
+// Maps the values of option "companies" to their company types.
+var companyTypes = map[string]companyType{
+	"distribution":    {field: "Distributors", testID: "sub-section-distribution"},
+	"other":           {field: "OtherCompanies", testID: "sub-section-miscellaneous"},
+	"production":      {field: "ProductionStudios", testID: "sub-section-production"},
+	"special-effects": {field: "SpecialEffectsCompanies", testID: "sub-section-specialEffects"},
+}
+
+// Company types that are scraped if option "companies" is not set.
+var defaultCompanyTypes = []string{"production", "distribution"}
+
+// A company listed on the companycredits page.
+type Company struct {
+	Name  string
+	Notes []string // Parenthesized annotations like "United States, 1983" or "theatrical"
+}
+
+// Returns the country the company's annotations refer to.
+// Returns countries.Unknown if no annotation starts with a known country.
+func (r *Company) Country() countries.CountryCode {
+	for _, note := range r.Notes {
+		name, _, _ := strings.Cut(note, ",")
+		if cc := countries.ByName(strings.TrimSpace(name)); cc.IsValid() {
+			return cc
+		}
+	}
+	return countries.Unknown
+}
+
+// represents "companycredits" pages https://www.imdb.com/title/$titleID/companycredits
+type CompanyCredits struct {
+	root *html.Node
+}
+
+func NewCompanyCredits(r io.Reader) (*CompanyCredits, error) {
+	root, err := html.Parse(r)
+	if err != nil {
+		return nil, err
+	}
+	return &CompanyCredits{
+		root: root,
+	}, nil
+}
+
+// Scrapes all companies from the subsection with the given test ID.
+func (r *CompanyCredits) Companies(testID string) ([]Company, error) {
+	section := rottensoup.FirstElementByAttr(r.root, html.Attribute{Key: attrTestID, Val: testID})
+	if section == nil {
+		return nil, fmt.Errorf("No subsection %q found", testID)
+	}
+	links := rottensoup.ElementsByAttrMatch(section, "", "href", matchCompanyLink)
+	if links == nil {
+		return nil, fmt.Errorf("No company links found in subsection %q", testID)
+	}
+	companies := make([]Company, 0, len(links))
+	for i, link := range links {
+		text := rottensoup.FirstNodeByType(link, html.TextNode)
+		if text == nil || strings.TrimSpace(text.Data) == "" {
+			global.Log.Infof("Company entry %d in subsection %q contains no name", i+1, testID)
+			continue
+		}
+		company := Company{Name: strings.TrimSpace(text.Data)}
+		if entry := enclosingListItem(link); entry != nil {
+			for _, match := range matchParenthesized.FindAllStringSubmatch(nodeText(entry), -1) {
+				company.Notes = append(company.Notes, strings.TrimSpace(match[1]))
+			}
+		}
+		companies = append(companies, company)
+	}
+	if len(companies) < 1 {
+		return nil, errors.New("No companies found")
+	}
+	return companies, nil
+}
+
+// Returns the names of the given companies as tags, omitting duplicates.
+func companyNames(companies []Company) []tags.UniLingual {
+	names := make([]tags.UniLingual, 0, len(companies))
+	for _, company := range companies {
+		name := tags.UniLingual(company.Name)
+		if !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
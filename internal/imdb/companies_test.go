@@ -0,0 +1,65 @@
+//This file is part of imdb2mkvtags ©2026 Jörg Walter
+
+package imdb
+
+import (
+	"github.com/biter777/countries"
+	"github.com/jwdev42/imdb2mkvtags/internal/tags"
+	"slices"
+	"testing"
+)
+
+func TestCompanies(t *testing.T) {
+	credits, err := NewCompanyCredits(openFixture(t, "companycredits.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := map[string][]struct {
+		name    string
+		notes   []string
+		country countries.CountryCode
+	}{
+		"sub-section-production": {
+			{"Warner Bros.", []string{"presents"}, countries.Unknown},
+			{"The Guber-Peters Company", nil, countries.Unknown},
+		},
+		"sub-section-distribution": {
+			{"Warner Bros.", []string{"United States, 1989, theatrical"}, countries.USA},
+			{"Warner Bros. Entertainment", []string{"Germany, 1989", "theatrical"}, countries.Germany},
+			{"Warner Home Video", []string{"2009, Blu-ray"}, countries.Unknown},
+		},
+	}
+	for testID, expected := range tests {
+		companies, err := credits.Companies(testID)
+		if err != nil {
+			t.Errorf("Companies(%q): %s", testID, err)
+			continue
+		}
+		if len(companies) != len(expected) {
+			t.Errorf("Companies(%q): Expected %d companies, got %d", testID, len(expected), len(companies))
+			continue
+		}
+		for i, company := range companies {
+			if company.Name != expected[i].name {
+				t.Errorf("Companies(%q)[%d]: Expected name %q, got %q", testID, i, expected[i].name, company.Name)
+			}
+			if !slices.Equal(company.Notes, expected[i].notes) {
+				t.Errorf("Companies(%q)[%d]: Expected notes %q, got %q", testID, i, expected[i].notes, company.Notes)
+			}
+			if country := company.Country(); country != expected[i].country {
+				t.Errorf("Companies(%q)[%d]: Expected country %s, got %s", testID, i, expected[i].country, country)
+			}
+		}
+	}
+	if _, err := credits.Companies("sub-section-specialEffects"); err == nil {
+		t.Error("Companies(\"sub-section-specialEffects\"): Expected error")
+	}
+}
+
+func TestCompanyNames(t *testing.T) {
+	companies := []Company{{Name: "Warner Bros."}, {Name: "Warner Home Video"}, {Name: "Warner Bros."}}
+	expected := []tags.UniLingual{"Warner Bros.", "Warner Home Video"}
+	if res := companyNames(companies); !slices.Equal(res, expected) {
+		t.Errorf("companyNames: Expected %q, got %q", expected, res)
+	}
+}
@@ -0,0 +1,123 @@
+//This file is part of imdb2mkvtags ©2026 Jörg Walter
+
+package imdb
+
+import (
+	"errors"
+	"github.com/jwdev42/rottensoup"
+	"golang.org/x/net/html"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var matchTitleLink = regexp.MustCompile("title\\/tt\\d+")
+var regexpTitleID = regexp.MustCompile("tt\\d+")
+var regexpConnectionYear = regexp.MustCompile("\\((\\d{4})[^)]*\\)(?:\\s*\\(([^)]+)\\))?")
+
+// A title listed on the movieconnections page.
+type Connection struct {
+	TitleID string
+	Title   string
+	Year    string // Empty if the page does not state the year
+	Type    string // Title type following the year like "TV Series" or "Short", empty for feature films
+}
+
+// Reports whether the connection is a feature film, as only other title types are annotated.
+func (r Connection) IsFeature() bool {
+	return r.Type == ""
+}
+
+// represents "movieconnections" pages https://www.imdb.com/title/$titleID/movieconnections
+type Connections struct {
+	root *html.Node
+}
+
+func NewConnections(r io.Reader) (*Connections, error) {
+	root, err := html.Parse(r)
+	if err != nil {
+		return nil, err
+	}
+	return &Connections{
+		root: root,
+	}, nil
+}
+
+// Scrapes the titles the movie is a sequel of, in the order of the page.
+func (r *Connections) Follows() []Connection {
+	return r.connections("follows")
+}
+
+// Scrapes the titles that are sequels of the movie, in the order of the page.
+func (r *Connections) FollowedBy() []Connection {
+	return r.connections("followed_by")
+}
+
+// Scrapes the titles the movie is a remake of, in the order of the page.
+func (r *Connections) RemakeOf() []Connection {
+	return r.connections("remake_of")
+}
+
+// Returns the linked titles of the section with the given anchor.
+// Returns nil if the section does not exist.
+func (r *Connections) connections(anchor string) []Connection {
+	section := sectionByAnchor(r.root, anchor)
+	if section == nil {
+		return nil
+	}
+	connections := make([]Connection, 0, 5)
+	for _, item := range outerListItems(section) {
+		links := rottensoup.ElementsByAttrMatch(item, "", "href", matchTitleLink)
+		if len(links) < 1 {
+			continue
+		}
+		link := links[0]
+		connection := Connection{
+			TitleID: regexpTitleID.FindString(rottensoup.AttrVal(link, "", "href")),
+			Title:   strings.TrimSpace(nodeText(link)),
+		}
+		if connection.Title == "" {
+			continue
+		}
+		if matches := regexpConnectionYear.FindStringSubmatch(nodeText(item)); matches != nil {
+			connection.Year = matches[1]
+			connection.Type = matches[2]
+		}
+		connections = append(connections, connection)
+	}
+	return connections
+}
+
+// A film series the movie is part of.
+type Series struct {
+	Title      string // Title of the series' first part
+	PartNumber int    // The movie's position in the series, starting with 1
+	TotalParts int
+}
+
+// Determines the series the movie is part of from its sequel connections.
+// Only feature films are counted as parts, TV series, shorts and the like are skipped.
+// Remakes start a series of their own, thus "remake of" connections are not followed.
+func (r *Connections) Series() (*Series, error) {
+	follows, followedBy := features(r.Follows()), features(r.FollowedBy())
+	if len(follows) < 1 && len(followedBy) < 1 {
+		if len(r.RemakeOf()) > 0 {
+			return nil, errors.New("Movie is a remake without sequels or prequels")
+		}
+		return nil, errors.New("Movie has no feature film sequels or prequels")
+	}
+	series := &Series{
+		PartNumber: len(follows) + 1,
+		TotalParts: len(follows) + 1 + len(followedBy),
+	}
+	if len(follows) > 0 {
+		series.Title = follows[0].Title
+	}
+	return series, nil
+}
+
+// Returns the connections that are feature films.
+func features(connections []Connection) []Connection {
+	return slices.DeleteFunc(connections, func(c Connection) bool { return !c.IsFeature() })
+}
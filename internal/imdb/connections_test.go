@@ -0,0 +1,58 @@
+//This file is part of imdb2mkvtags ©2026 Jörg Walter
+
+package imdb
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestConnections(t *testing.T) {
+	connections, err := NewConnections(openFixture(t, "movieconnections.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := map[string]struct {
+		res, expected []Connection
+	}{
+		"Follows": {connections.Follows(), []Connection{
+			{TitleID: "tt0060153", Title: "Batman", Year: "1966", Type: "TV Series"},
+			{TitleID: "tt0096895", Title: "Batman", Year: "1989"},
+			{TitleID: "tt0103776", Title: "Batman Returns", Year: "1992"},
+		}},
+		"FollowedBy": {connections.FollowedBy(), []Connection{
+			{TitleID: "tt0103359", Title: "Batman: The Animated Series", Year: "1992", Type: "TV Series"},
+			{TitleID: "tt0118688", Title: "Batman & Robin", Year: "1997"},
+		}},
+		"RemakeOf": {connections.RemakeOf(), nil},
+	}
+	for name, test := range tests {
+		if !slices.Equal(test.res, test.expected) {
+			t.Errorf("%s: Expected %v, got %v", name, test.expected, test.res)
+		}
+	}
+	series, err := connections.Series()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := (Series{Title: "Batman", PartNumber: 3, TotalParts: 4}); *series != expected {
+		t.Errorf("Series: Expected %+v, got %+v", expected, *series)
+	}
+}
+
+func TestSeriesWithoutSequels(t *testing.T) {
+	for _, page := range []string{
+		`<section><span id="remake_of"></span><ul><li><a href="/title/tt0012345/">Original</a> (1950)</li></ul></section>`,
+		`<section><span id="references"></span><ul><li><a href="/title/tt0012345/">Original</a> (1950)</li></ul></section>`,
+		`<section><span id="follows"></span><ul><li><a href="/title/tt0012345/">Original</a> (1950) (TV Movie)</li></ul></section>`,
+	} {
+		connections, err := NewConnections(strings.NewReader(page))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if series, err := connections.Series(); err == nil {
+			t.Errorf("Series(%q): Expected error, got %+v", page, *series)
+		}
+	}
+}
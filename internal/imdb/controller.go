@@ -6,14 +6,20 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"github.com/biter777/countries"
 	"github.com/jwdev42/imdb2mkvtags/internal/cmdline"
+	"github.com/jwdev42/imdb2mkvtags/internal/genre"
 	"github.com/jwdev42/imdb2mkvtags/internal/global"
 	ihttp "github.com/jwdev42/imdb2mkvtags/internal/http"
+	"github.com/jwdev42/imdb2mkvtags/internal/langid"
 	"github.com/jwdev42/imdb2mkvtags/internal/lcconv"
 	"github.com/jwdev42/imdb2mkvtags/internal/tags"
 	"io"
 	"net/url"
+	"os"
 	"path"
+	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -22,11 +28,58 @@ import (
 // Holds IMDB-specific options passed via parameter "opts".
 // Also holds common opts that need to be known.
 type options struct {
-	UseJsonLD      bool
-	UseFullCredits bool
-	UseKeywords    bool
-	KeywordLimit   int
-	UserAgent      string // User Agent for HTTP client
+	UseJsonLD            bool
+	UseFullCredits       bool
+	UseKeywords          bool
+	UseCompanyCredits    bool
+	UseParentalGuide     bool
+	UseAdvisories        bool
+	UseReleaseInfo       bool
+	UseAKAs              bool
+	UseRatings           bool
+	UseTechnical         bool
+	UseLocations         bool
+	UseTaglines          bool
+	UsePlotSummary       bool
+	UseSpoilers          bool
+	UseSoundtrack        bool
+	UseAwards            bool
+	UseBoxOffice         bool
+	UseConnections       bool
+	UseMultiLang         bool
+	UseInterests         bool
+	TranslateGenres      bool
+	AwardWinsOnly        bool
+	KeywordLimit         int
+	LocationLimit        int
+	GenreLimit           int
+	InterestLimit        int
+	GenreMap             genre.Mapping
+	Crew                 []string // Crew categories to scrape from the fullcredits page
+	Companies            []string // Company types to scrape from the companycredits page
+	DistributorCountry   bool     // Only accept distributors for the preferred language's country
+	CertificateCountries []string // Alpha-2 codes of the countries whose certificates are accepted
+	ReleaseCountries     []string // Alpha-2 codes of the countries whose release dates are accepted
+	AwardEvents          []string // IDs of the award events to accept
+	TaglineTag           string   // Tag name for taglines
+	InterestTag          string   // Tag name for interests, interests are merged into the keywords if empty
+	CollectionTitle      string   // Overrides the title of the movie's collection
+	LangID               string   // Handling of texts whose identified language differs from their label, "correct" or "drop"
+	UserAgent            string   // User Agent for HTTP client
+}
+
+var regexpTagName = regexp.MustCompile("^[A-Z0-9_]+$")
+
+// Maps the locale path segments of IMDB URLs, e.g. "https://www.imdb.com/de/title/tt0082096/", to their languages.
+var urlLocales = map[string]string{
+	"de":    "de-DE",
+	"es":    "es-MX",
+	"es-es": "es-ES",
+	"fr":    "fr-FR",
+	"fr-ca": "fr-CA",
+	"hi":    "hi-IN",
+	"it":    "it-IT",
+	"pt":    "pt-BR",
 }
 
 type Controller struct {
@@ -36,6 +89,7 @@ type Controller struct {
 	lang        []*lcconv.LngCntry
 	defaultLang *lcconv.LngCntry
 	titleID     string
+	credits     *Credits // The parsed fullcredits page, nil until it was fetched
 }
 
 func NewController(rawurl string) (*Controller, error) {
@@ -52,7 +106,7 @@ func NewController(rawurl string) (*Controller, error) {
 	// Create controller
 	cntrl := &Controller{
 		urlScheme:   u.Scheme,
-		o:           new(options),
+		o:           &options{Companies: defaultCompanyTypes, UseInterests: true},
 		lang:        make([]*lcconv.LngCntry, 0),
 		defaultLang: defaultLang,
 	}
@@ -73,6 +127,18 @@ func NewController(rawurl string) (*Controller, error) {
 	}
 	path := strings.Split(u.Path, "/")
 	if len(path) >= 4 && path[2] == "title" && IsTitleID(path[3]) {
+		locale := strings.ToLower(path[1])
+		tag, ok := urlLocales[locale]
+		if !ok {
+			global.Log.Errorf("Unsupported IMDB locale %q in URL path", path[1])
+			return nil, errors.New(urlValidationFailed)
+		}
+		lang, err := lcconv.NewLngCntry(tag)
+		if err != nil {
+			panic(fmt.Errorf("invalid language %q hardcoded into the program for IMDB locale %q", tag, locale))
+		}
+		cntrl.urlCountry = locale
+		cntrl.lang = []*lcconv.LngCntry{lang}
 		cntrl.titleID = path[3]
 	} else if len(path) >= 3 && path[1] == "title" && IsTitleID(path[2]) {
 		cntrl.titleID = path[2]
@@ -99,13 +165,29 @@ func (r *Controller) PreferredLang() *lcconv.LngCntry {
 
 // Return the controller's title URL.
 func (r *Controller) TitleURL() string {
-	if r.urlCountry != "" {
+	return r.titleURL(r.urlCountry)
+}
+
+// Return the title URL with the given locale path segment, no locale is used if locale is empty.
+func (r *Controller) titleURL(locale string) string {
+	if locale != "" {
 		return fmt.Sprintf("%s://imdb.com/%s/title/%s",
-			r.urlScheme, url.PathEscape(r.urlCountry), url.PathEscape(r.titleID))
+			r.urlScheme, url.PathEscape(locale), url.PathEscape(r.titleID))
 	}
 	return fmt.Sprintf("%s://imdb.com/title/%s", r.urlScheme, url.PathEscape(r.titleID))
 }
 
+// Returns the locale path segment of IMDB URLs whose language is lang.
+// Returns an empty string if IMDB has no locale for lang.
+func urlLocale(lang *lcconv.LngCntry) string {
+	for locale, tag := range urlLocales {
+		if strings.EqualFold(tag, lang.String()) {
+			return locale
+		}
+	}
+	return ""
+}
+
 // Return the controller's credits page URL.
 func (r *Controller) CreditsURL() string {
 	return r.TitleURL() + "/fullcredits"
@@ -116,6 +198,56 @@ func (r *Controller) KeywordsURL() string {
 	return r.TitleURL() + "/keywords"
 }
 
+// Return the controller's company credits page URL.
+func (r *Controller) CompanyCreditsURL() string {
+	return r.TitleURL() + "/companycredits"
+}
+
+// Return the controller's release info page URL.
+func (r *Controller) ReleaseInfoURL() string {
+	return r.TitleURL() + "/releaseinfo"
+}
+
+// Return the controller's technical specifications page URL.
+func (r *Controller) TechnicalURL() string {
+	return r.TitleURL() + "/technical"
+}
+
+// Return the controller's filming locations page URL.
+func (r *Controller) LocationsURL() string {
+	return r.TitleURL() + "/locations"
+}
+
+// Return the controller's taglines page URL.
+func (r *Controller) TaglinesURL() string {
+	return r.TitleURL() + "/taglines"
+}
+
+// Return the controller's plot summary page URL.
+func (r *Controller) PlotSummaryURL() string {
+	return r.TitleURL() + "/plotsummary"
+}
+
+// Return the controller's soundtrack page URL.
+func (r *Controller) SoundtrackURL() string {
+	return r.TitleURL() + "/soundtrack"
+}
+
+// Return the controller's awards page URL.
+func (r *Controller) AwardsURL() string {
+	return r.TitleURL() + "/awards"
+}
+
+// Return the controller's movie connections page URL.
+func (r *Controller) ConnectionsURL() string {
+	return r.TitleURL() + "/movieconnections"
+}
+
+// Return the controller's parental guide page URL.
+func (r *Controller) ParentalGuideURL() string {
+	return r.TitleURL() + "/parentalguide"
+}
+
 // Parses controller options. Reconfigures the controller after parsing was successful.
 func (r *Controller) SetOptions(flags *cmdline.Flags) error {
 
@@ -159,15 +291,174 @@ func (r *Controller) SetOptions(flags *cmdline.Flags) error {
 					return fmt.Errorf("Illegal argument for %s", arg[0])
 				}
 				r.o.KeywordLimit = limit
+			case "companycredits":
+				if err := parseBool(arg[1], &r.o.UseCompanyCredits); err != nil {
+					return fmt.Errorf(malformedVal, pair)
+				}
+			case "companies":
+				companies, err := parseCompanies(arg[1])
+				if err != nil {
+					return fmt.Errorf("Illegal argument for %s: %s", arg[0], err)
+				}
+				r.o.Companies = companies
+			case "distributor-country":
+				if err := parseBool(arg[1], &r.o.DistributorCountry); err != nil {
+					return fmt.Errorf(malformedVal, pair)
+				}
+			case "parentalguide":
+				if err := parseBool(arg[1], &r.o.UseParentalGuide); err != nil {
+					return fmt.Errorf(malformedVal, pair)
+				}
+			case "advisories":
+				if err := parseBool(arg[1], &r.o.UseAdvisories); err != nil {
+					return fmt.Errorf(malformedVal, pair)
+				}
+			case "certificate-countries":
+				codes, err := parseCountryCodes(arg[1])
+				if err != nil {
+					return fmt.Errorf("Illegal argument for %s: %s", arg[0], err)
+				}
+				r.o.CertificateCountries = codes
+			case "releaseinfo":
+				if err := parseBool(arg[1], &r.o.UseReleaseInfo); err != nil {
+					return fmt.Errorf(malformedVal, pair)
+				}
+			case "release-countries":
+				codes, err := parseCountryCodes(arg[1])
+				if err != nil {
+					return fmt.Errorf("Illegal argument for %s: %s", arg[0], err)
+				}
+				r.o.ReleaseCountries = codes
+			case "akas":
+				if err := parseBool(arg[1], &r.o.UseAKAs); err != nil {
+					return fmt.Errorf(malformedVal, pair)
+				}
+			case "ratings":
+				if err := parseBool(arg[1], &r.o.UseRatings); err != nil {
+					return fmt.Errorf(malformedVal, pair)
+				}
+			case "technical":
+				if err := parseBool(arg[1], &r.o.UseTechnical); err != nil {
+					return fmt.Errorf(malformedVal, pair)
+				}
+			case "locations":
+				if err := parseBool(arg[1], &r.o.UseLocations); err != nil {
+					return fmt.Errorf(malformedVal, pair)
+				}
+			case "location-limit":
+				limit, err := strconv.Atoi(arg[1])
+				if err != nil {
+					return fmt.Errorf("Illegal argument for %s", arg[0])
+				}
+				r.o.LocationLimit = limit
+			case "taglines":
+				if err := parseBool(arg[1], &r.o.UseTaglines); err != nil {
+					return fmt.Errorf(malformedVal, pair)
+				}
+			case "tagline-tag":
+				if !regexpTagName.MatchString(arg[1]) {
+					return fmt.Errorf("Illegal argument for %s: Tag names must consist of capital letters, digits and underscores", arg[0])
+				}
+				r.o.TaglineTag = arg[1]
+			case "plotsummary":
+				if err := parseBool(arg[1], &r.o.UsePlotSummary); err != nil {
+					return fmt.Errorf(malformedVal, pair)
+				}
+			case "spoilers":
+				if err := parseBool(arg[1], &r.o.UseSpoilers); err != nil {
+					return fmt.Errorf(malformedVal, pair)
+				}
+			case "soundtrack":
+				if err := parseBool(arg[1], &r.o.UseSoundtrack); err != nil {
+					return fmt.Errorf(malformedVal, pair)
+				}
+			case "awards":
+				if err := parseBool(arg[1], &r.o.UseAwards); err != nil {
+					return fmt.Errorf(malformedVal, pair)
+				}
+			case "award-wins-only":
+				if err := parseBool(arg[1], &r.o.AwardWinsOnly); err != nil {
+					return fmt.Errorf(malformedVal, pair)
+				}
+			case "award-events":
+				events, err := parseAwardEvents(arg[1])
+				if err != nil {
+					return fmt.Errorf("Illegal argument for %s: %s", arg[0], err)
+				}
+				r.o.AwardEvents = events
+			case "boxoffice":
+				if err := parseBool(arg[1], &r.o.UseBoxOffice); err != nil {
+					return fmt.Errorf(malformedVal, pair)
+				}
+			case "langid":
+				if arg[1] != "correct" && arg[1] != "drop" {
+					return fmt.Errorf("Illegal argument for %s: Expected \"correct\" or \"drop\"", arg[0])
+				}
+				r.o.LangID = arg[1]
+			case "multilang":
+				if err := parseBool(arg[1], &r.o.UseMultiLang); err != nil {
+					return fmt.Errorf(malformedVal, pair)
+				}
+			case "translate-genres":
+				if err := parseBool(arg[1], &r.o.TranslateGenres); err != nil {
+					return fmt.Errorf(malformedVal, pair)
+				}
+			case "genre-map":
+				m, err := readGenreMap(arg[1])
+				if err != nil {
+					return fmt.Errorf("Illegal argument for %s: %s", arg[0], err)
+				}
+				r.o.GenreMap = m
+			case "genre-limit":
+				limit, err := strconv.Atoi(arg[1])
+				if err != nil {
+					return fmt.Errorf("Illegal argument for %s", arg[0])
+				}
+				r.o.GenreLimit = limit
+			case "interests":
+				if err := parseBool(arg[1], &r.o.UseInterests); err != nil {
+					return fmt.Errorf(malformedVal, pair)
+				}
+			case "interest-limit":
+				limit, err := strconv.Atoi(arg[1])
+				if err != nil {
+					return fmt.Errorf("Illegal argument for %s", arg[0])
+				}
+				r.o.InterestLimit = limit
+			case "interest-tag":
+				if !regexpTagName.MatchString(arg[1]) {
+					return fmt.Errorf("Illegal argument for %s: Tag names must consist of capital letters, digits and underscores", arg[0])
+				}
+				r.o.InterestTag = arg[1]
+			case "connections":
+				if err := parseBool(arg[1], &r.o.UseConnections); err != nil {
+					return fmt.Errorf(malformedVal, pair)
+				}
+			case "collection":
+				if strings.TrimSpace(arg[1]) == "" {
+					return fmt.Errorf("Illegal argument for %s: Collection title must not be empty", arg[0])
+				}
+				r.o.CollectionTitle = arg[1]
+			case "crew":
+				crew, err := parseCrew(arg[1])
+				if err != nil {
+					return fmt.Errorf("Illegal argument for %s: %s", arg[0], err)
+				}
+				r.o.Crew = crew
 			default:
 				return fmt.Errorf("Unknown argument: %s", arg[0])
 			}
 		}
 	}
 
-	// Parse language option
-	if flags.Lang != nil {
+	// Parse language option, the language of the URL's locale is used if no language was set
+	if len(flags.Lang) > 0 {
 		r.lang = flags.Lang
+		// IMDB localizes pages by the URL's locale regardless of Accept-Language
+		if r.urlCountry != "" && urlLocale(r.PreferredLang()) != r.urlCountry {
+			global.Log.Infof("Dropping locale %q from the URL as it does not match language %s", r.urlCountry, r.PreferredLang())
+			r.urlCountry = ""
+		}
 	}
 
 	return nil
@@ -180,6 +471,9 @@ func (r *Controller) Scrape() (*tags.Movie, error) {
 		return nil, err
 	}
 
+	// Keep the title page for scrapers that fall back to its json-ld data
+	titlePage := body.Bytes()
+
 	var movie *tags.Movie
 
 	if r.o.UseJsonLD {
@@ -188,6 +482,13 @@ func (r *Controller) Scrape() (*tags.Movie, error) {
 			return nil, err
 		}
 		movie = json.Convert(r.PreferredLang(), r.DefaultLang())
+		if r.o.TranslateGenres {
+			// JSON-LD genres are always english
+			movie.Genres = translateGenres(movie.Genres, r.PreferredLang().Language())
+		}
+		if r.o.UseRatings {
+			movie.SetFieldCallback("Ratings", json.Ratings)
+		}
 	} else {
 		if t, err := r.scrapeTitlePage(body); err != nil {
 			return nil, err
@@ -196,6 +497,15 @@ func (r *Controller) Scrape() (*tags.Movie, error) {
 		}
 	}
 
+	// The title page has already been fetched in the preferred language
+	if r.o.UseMultiLang && len(r.lang) > 1 {
+		for _, lang := range r.lang[1:] {
+			if err := r.scrapeLocalizedTitlePage(movie, lang); err != nil {
+				global.Log.Error(fmt.Errorf("Could not scrape title page in language %s: %s", lang.HttpHeader(), err))
+			}
+		}
+	}
+
 	if r.o.UseFullCredits {
 		if err := r.scrapeFullCredits(movie); err != nil {
 			global.Log.Error(fmt.Errorf("Could not scrape full credits: %s", err))
@@ -208,39 +518,732 @@ func (r *Controller) Scrape() (*tags.Movie, error) {
 		}
 	}
 
+	if r.o.UseParentalGuide {
+		if err := r.scrapeParentalGuide(movie); err != nil {
+			global.Log.Error(fmt.Errorf("Could not scrape parental guide: %s", err))
+		}
+	}
+
+	if r.o.UseReleaseInfo {
+		if err := r.scrapeReleaseInfo(movie); err != nil {
+			global.Log.Error(fmt.Errorf("Could not scrape release info: %s", err))
+		}
+	}
+
+	if r.o.UseTechnical {
+		if err := r.scrapeTechnical(movie, titlePage); err != nil {
+			global.Log.Error(fmt.Errorf("Could not scrape technical specifications: %s", err))
+		}
+	}
+
+	if r.o.UseLocations {
+		if err := r.scrapeLocations(movie); err != nil {
+			global.Log.Error(fmt.Errorf("Could not scrape filming locations: %s", err))
+		}
+	}
+
+	if r.o.UseTaglines {
+		if err := r.scrapeTaglines(movie); err != nil {
+			global.Log.Error(fmt.Errorf("Could not scrape taglines: %s", err))
+		}
+	}
+
+	if r.o.UsePlotSummary {
+		if err := r.scrapePlotSummary(movie); err != nil {
+			global.Log.Error(fmt.Errorf("Could not scrape plot summary: %s", err))
+		}
+	}
+
+	if r.o.UseSoundtrack {
+		if err := r.scrapeSoundtrack(movie); err != nil {
+			global.Log.Error(fmt.Errorf("Could not scrape soundtrack: %s", err))
+		}
+	}
+
+	if r.o.UseAwards {
+		if err := r.scrapeAwards(movie); err != nil {
+			global.Log.Error(fmt.Errorf("Could not scrape awards: %s", err))
+		}
+	}
+
+	if r.o.UseBoxOffice {
+		if err := r.scrapeBoxOffice(movie, titlePage); err != nil {
+			global.Log.Error(fmt.Errorf("Could not scrape box office: %s", err))
+		}
+	}
+
+	if r.o.UseCompanyCredits {
+		if err := r.scrapeCompanyCredits(movie); err != nil {
+			global.Log.Error(fmt.Errorf("Could not scrape company credits: %s", err))
+		}
+	}
+
+	if r.o.UseConnections {
+		if err := r.scrapeConnections(movie); err != nil {
+			global.Log.Error(fmt.Errorf("Could not scrape movie connections: %s", err))
+		}
+	}
+
+	if r.o.LangID != "" {
+		movie.Synopses = r.checkLanguages(movie.Synopses)
+		movie.Summaries = r.checkLanguages(movie.Summaries)
+		movie.Taglines = r.checkLanguages(movie.Taglines)
+		movie.Titles = r.checkLanguages(movie.Titles)
+	}
+
+	if r.o.GenreMap != nil {
+		movie.Genres = mapGenres(movie.Genres, r.o.GenreMap)
+	}
+	movie.Genres = limitPerLanguage(movie.Genres, r.o.GenreLimit)
+
+	if len(movie.Interests) > 0 {
+		interests := limitPerLanguage(movie.Interests, r.o.InterestLimit)
+		if r.o.InterestTag == "" || r.o.InterestTag == "KEYWORDS" {
+			movie.Keywords = mergeInterests(movie.Keywords, interests)
+			movie.Interests = nil
+		} else {
+			movie.Interests = interests
+			movie.InterestTag = r.o.InterestTag
+		}
+	}
+
 	movie.Imdb = tags.UniLingual(r.titleID)
 	movie.DateTagged = tags.UniLingual(time.Now().Format("2006-01-02"))
 
 	return movie, nil
 }
 
-func (r *Controller) scrapeFullCredits(movie *tags.Movie) error {
+// Returns the parsed fullcredits page, the page is only fetched once.
+func (r *Controller) fullCredits() (*Credits, error) {
+	if r.credits != nil {
+		return r.credits, nil
+	}
 	global.Log.Debug("Scraping credits page")
-	fetchFullCredits := func() (io.Reader, error) {
-		body := new(bytes.Buffer)
-		if err := ihttp.GetBody(nil, r.o.UserAgent, r.CreditsURL(), body, r.lang...); err != nil {
-			return nil, err
-		}
-		return body, nil
+	body, err := r.fetchPage(r.CreditsURL())
+	if err != nil {
+		return nil, fmt.Errorf("Could not fetch page: %s", err)
 	}
-
-	body, err := fetchFullCredits()
+	credits, err := NewCredits(body)
 	if err != nil {
-		return fmt.Errorf("Fullcredits: Could not fetch page: %s", err)
+		return nil, fmt.Errorf("Could not parse document: %s", err)
 	}
+	r.credits = credits
+	return credits, nil
+}
 
-	credits, err := NewCredits(body)
+func (r *Controller) scrapeFullCredits(movie *tags.Movie) error {
+	credits, err := r.fullCredits()
 	if err != nil {
-		return fmt.Errorf("Fullcredits: Could not parse document: %s", err)
+		return fmt.Errorf("Fullcredits: %s", err)
 	}
 
 	movie.SetFieldCallback("Actors", credits.Actors)
 	movie.SetFieldCallback("Directors", credits.Directors)
 	movie.SetFieldCallback("Producers", credits.Producers)
 	movie.SetFieldCallback("Writers", credits.Writers)
+	for _, name := range r.o.Crew {
+		category := crewCategories[name]
+		movie.SetFieldCallback(category.field, func() ([]*tags.Person, error) {
+			return credits.Crew(category.anchor)
+		})
+	}
+	return nil
+}
+
+// Parses the value of option "companies".
+func parseCompanies(value string) ([]string, error) {
+	var companies []string
+	for _, name := range strings.Split(value, global.DelimControllerList) {
+		if _, ok := companyTypes[name]; !ok {
+			return nil, fmt.Errorf("Unknown company type %q", name)
+		}
+		if !slices.Contains(companies, name) {
+			companies = append(companies, name)
+		}
+	}
+	return companies, nil
+}
+
+// Parses the value of option "award-events". Event aliases are replaced by their IDs.
+func parseAwardEvents(value string) ([]string, error) {
+	events := strings.Split(value, global.DelimControllerList)
+	for i, event := range events {
+		if id, ok := awardEventAliases[event]; ok {
+			events[i] = id
+		} else if !regexpEventID.MatchString(event) {
+			return nil, fmt.Errorf("Unknown award event %q", event)
+		}
+	}
+	return events, nil
+}
+
+// Parses a list of Alpha-2 country codes.
+func parseCountryCodes(value string) ([]string, error) {
+	codes := strings.Split(value, global.DelimControllerList)
+	for _, code := range codes {
+		if cc := countries.ByName(code); !cc.IsValid() || cc.Alpha2() != code {
+			return nil, fmt.Errorf("Invalid country code %q", code)
+		}
+	}
+	return codes, nil
+}
+
+// Parses the value of option "crew". Value "all" selects every crew category.
+func parseCrew(value string) ([]string, error) {
+	if value == "all" {
+		return crewCategoryNames(), nil
+	}
+	var crew []string
+	for _, name := range strings.Split(value, global.DelimControllerList) {
+		if _, ok := crewCategories[name]; !ok {
+			return nil, fmt.Errorf("Unknown crew category %q", name)
+		}
+		if !slices.Contains(crew, name) {
+			crew = append(crew, name)
+		}
+	}
+	return crew, nil
+}
+
+func (r *Controller) scrapeCompanyCredits(movie *tags.Movie) error {
+	global.Log.Debug("Scraping company credits page")
+	body, err := r.fetchPage(r.CompanyCreditsURL())
+	if err != nil {
+		return fmt.Errorf("Company credits: Could not fetch page: %s", err)
+	}
+	credits, err := NewCompanyCredits(body)
+	if err != nil {
+		return fmt.Errorf("Company credits: Could not parse document: %s", err)
+	}
+	for _, name := range r.o.Companies {
+		ct := companyTypes[name]
+		movie.SetFieldCallback(ct.field, func() ([]tags.UniLingual, error) {
+			companies, err := credits.Companies(ct.testID)
+			if err != nil {
+				return nil, err
+			}
+			if name == "distribution" && r.o.DistributorCountry {
+				if r.PreferredLang().Alpha2() == "" {
+					global.Log.Warningf("Company credits: Preferred language %s has no country, distributors are not filtered", r.PreferredLang())
+					return companyNames(companies), nil
+				}
+				companies = r.filterDistributors(companies)
+				if len(companies) < 1 {
+					return nil, fmt.Errorf("No distributors found for country %s", r.PreferredLang().Alpha2())
+				}
+			}
+			return companyNames(companies), nil
+		})
+	}
+	return nil
+}
+
+func (r *Controller) scrapeReleaseInfo(movie *tags.Movie) error {
+	global.Log.Debug("Scraping release info page")
+	body, err := r.fetchPage(r.ReleaseInfoURL())
+	if err != nil {
+		return fmt.Errorf("Release info: Could not fetch page: %s", err)
+	}
+	info, err := NewReleaseInfo(body)
+	if err != nil {
+		return fmt.Errorf("Release info: Could not parse document: %s", err)
+	}
+	if releases, err := info.Releases(); err != nil {
+		global.Log.Error(fmt.Errorf("Release info: %s", err))
+	} else {
+		for _, release := range releases {
+			if len(r.o.ReleaseCountries) > 0 && !slices.Contains(r.o.ReleaseCountries, release.Country.Alpha2()) {
+				continue
+			}
+			country := movie.Country(release.Country.Alpha3())
+			if country.DateReleased != "" {
+				// Only the first and therefore earliest release of a country is kept
+				continue
+			}
+			country.SetFieldCallback("DateReleased", func() (tags.UniLingual, error) {
+				return tags.UniLingual(release.Date), nil
+			})
+		}
+	}
+	if r.o.UseAKAs {
+		akas, err := info.AKAs()
+		if err != nil {
+			return fmt.Errorf("Release info: %s", err)
+		}
+		movie.Titles = mergeAKAs(movie.Titles, akas)
+	}
+	return nil
+}
+
+// Appends the alternative titles to titles, omitting duplicates. If a title's text equals an
+// alternative title of known language, the title's language is corrected accordingly.
+// Alternative titles of unknown language are skipped as they could not be told apart from the title.
+func mergeAKAs(titles []tags.MultiLingual, akas []AKA) []tags.MultiLingual {
+	for i := range titles {
+		var langs []string
+		for _, aka := range akas {
+			if aka.Title == titles[i].Text && aka.Lang != "" {
+				langs = append(langs, aka.Lang)
+			}
+		}
+		if len(langs) > 0 && !slices.Contains(langs, titles[i].Lang) {
+			global.Log.Debugf("Correcting language of title %q from %q to %q", titles[i].Text, titles[i].Lang, langs[0])
+			titles[i].Lang = langs[0]
+		}
+	}
+	for _, aka := range akas {
+		if aka.Lang == "" {
+			global.Log.Debugf("Skipping alternative title %q of unknown language", aka.Title)
+			continue
+		}
+		title := tags.MultiLingual{Text: aka.Title, Lang: aka.Lang}
+		if !slices.Contains(titles, title) {
+			titles = append(titles, title)
+		}
+	}
+	return titles
+}
+
+// Scrapes the technical specifications page. If it does not provide a runtime,
+// the runtime is taken from the title page's json-ld data.
+func (r *Controller) scrapeTechnical(movie *tags.Movie, titlePage []byte) error {
+	global.Log.Debug("Scraping technical specifications page")
+	movie.SetFieldCallback("TechSpecs", func() (*tags.TechSpecs, error) {
+		body, err := r.fetchPage(r.TechnicalURL())
+		if err != nil {
+			return nil, fmt.Errorf("Technical specifications: Could not fetch page: %s", err)
+		}
+		technical, err := NewTechnical(body)
+		if err != nil {
+			return nil, fmt.Errorf("Technical specifications: Could not parse document: %s", err)
+		}
+		return technical.Specs()
+	})
+	if movie.TechSpecs != nil && len(movie.TechSpecs.Runtimes) > 0 {
+		return nil
+	}
+	global.Log.Debug("Using json-ld duration as runtime")
+	json, err := ExtractMovieSchema(bytes.NewReader(titlePage))
+	if err != nil {
+		return err
+	}
+	if movie.TechSpecs == nil {
+		movie.TechSpecs = new(tags.TechSpecs)
+	}
+	movie.TechSpecs.SetFieldCallback("Runtimes", func() ([]*tags.Runtime, error) {
+		minutes, err := json.Runtime()
+		if err != nil {
+			return nil, err
+		}
+		return []*tags.Runtime{{Minutes: strconv.Itoa(minutes)}}, nil
+	})
+	return nil
+}
+
+func (r *Controller) scrapeLocations(movie *tags.Movie) error {
+	global.Log.Debug("Scraping filming locations page")
+	body, err := r.fetchPage(r.LocationsURL())
+	if err != nil {
+		return fmt.Errorf("Filming locations: Could not fetch page: %s", err)
+	}
+	locations, err := NewLocations(body)
+	if err != nil {
+		return fmt.Errorf("Filming locations: Could not parse document: %s", err)
+	}
+	movie.SetFieldCallback("RecordingLocations", func() ([]tags.UniLingual, error) {
+		list, err := locations.FilmingLocations()
+		if err != nil {
+			return nil, err
+		}
+		if r.o.LocationLimit > 0 && r.o.LocationLimit < len(list) {
+			list = list[:r.o.LocationLimit]
+		}
+		global.Log.Debugf("scrapeLocations: Adding %d filming locations", len(list))
+		return list, nil
+	})
+	movie.SetFieldCallback("DateRecorded", locations.DateRecorded)
+	return nil
+}
+
+// Scrapes the taglines page. Only the first tagline is kept.
+func (r *Controller) scrapeTaglines(movie *tags.Movie) error {
+	global.Log.Debug("Scraping taglines page")
+	body, err := r.fetchPage(r.TaglinesURL())
+	if err != nil {
+		return fmt.Errorf("Taglines: Could not fetch page: %s", err)
+	}
+	plot, err := NewPlot(body, r.PreferredLang().Language())
+	if err != nil {
+		return fmt.Errorf("Taglines: Could not parse document: %s", err)
+	}
+	taglines, err := plot.Taglines()
+	if err != nil {
+		return fmt.Errorf("Taglines: %s", err)
+	}
+	movie.Taglines = taglines[:1]
+	movie.TaglineTag = r.o.TaglineTag
+	return nil
+}
+
+// Scrapes the plot summary page. The outline replaces the title page's plot which moves from
+// SYNOPSIS to SUMMARY. The synopsis is only kept if spoilers are enabled.
+func (r *Controller) scrapePlotSummary(movie *tags.Movie) error {
+	global.Log.Debug("Scraping plot summary page")
+	body, err := r.fetchPage(r.PlotSummaryURL())
+	if err != nil {
+		return fmt.Errorf("Plot summary: Could not fetch page: %s", err)
+	}
+	plot, err := NewPlot(body, r.PreferredLang().Language())
+	if err != nil {
+		return fmt.Errorf("Plot summary: Could not parse document: %s", err)
+	}
+	summaries, err := plot.Summaries()
+	if err != nil {
+		return fmt.Errorf("Plot summary: %s", err)
+	}
+	// The title page's short plots are summaries as well,
+	// the outline replaces the short plot of its language.
+	outline := summaries[0]
+	movie.Summaries = append(movie.Summaries, outline)
+	for _, synopsis := range movie.Synopses {
+		if !sameLanguage(synopsis.Lang, outline.Lang) {
+			movie.Summaries = append(movie.Summaries, synopsis)
+		}
+	}
+	movie.Synopses = nil
+	if r.o.UseSpoilers {
+		movie.SetFieldCallback("Synopses", plot.Synopses)
+	}
+	return nil
+}
+
+// Reports whether the language tags a and b have the same language subtag, e.g. "de" and "de-DE".
+// Tags that cannot be parsed only match if they are equal.
+func sameLanguage(a, b string) bool {
+	langA, errA := lcconv.NewLngCntry(a)
+	langB, errB := lcconv.NewLngCntry(b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+	return langA.Language() == langB.Language()
+}
+
+// Scrapes the soundtrack page. The composers of the score, as credited in the fullcredits page's
+// composer category, are merged into the movie's composers.
+func (r *Controller) scrapeSoundtrack(movie *tags.Movie) error {
+	global.Log.Debug("Scraping soundtrack page")
+	body, err := r.fetchPage(r.SoundtrackURL())
+	if err != nil {
+		return fmt.Errorf("Soundtrack: Could not fetch page: %s", err)
+	}
+	soundtrack, err := NewSoundtrack(body)
+	if err != nil {
+		return fmt.Errorf("Soundtrack: Could not parse document: %s", err)
+	}
+	songs, err := soundtrack.Songs()
+	if err != nil {
+		return fmt.Errorf("Soundtrack: %s", err)
+	}
+	movie.Songs = songs
+	credits, err := r.fullCredits()
+	if err != nil {
+		global.Log.Info(fmt.Errorf("Soundtrack: Could not determine the score composers: Fullcredits: %s", err))
+		return nil
+	}
+	composers, err := credits.Crew(crewCategories["composer"].anchor)
+	if err != nil {
+		global.Log.Info(fmt.Errorf("Soundtrack: Could not determine the score composers: %s", err))
+		return nil
+	}
+	movie.Composers = mergePersons(movie.Composers, composers)
+	return nil
+}
+
+func (r *Controller) scrapeAwards(movie *tags.Movie) error {
+	global.Log.Debug("Scraping awards page")
+	body, err := r.fetchPage(r.AwardsURL())
+	if err != nil {
+		return fmt.Errorf("Awards: Could not fetch page: %s", err)
+	}
+	page, err := NewAwards(body)
+	if err != nil {
+		return fmt.Errorf("Awards: Could not parse document: %s", err)
+	}
+	awards, err := page.Awards()
+	if err != nil {
+		return fmt.Errorf("Awards: %s", err)
+	}
+	filtered := make([]*tags.Award, 0, len(awards))
+	for _, award := range awards {
+		if len(r.o.AwardEvents) > 0 && !slices.Contains(r.o.AwardEvents, string(award.EventID)) {
+			continue
+		}
+		if r.o.AwardWinsOnly && !isAwardWin(award) {
+			continue
+		}
+		filtered = append(filtered, award)
+	}
+	global.Log.Debugf("scrapeAwards: Adding %d of %d awards", len(filtered), len(awards))
+	movie.Awards = filtered
+	return nil
+}
+
+// Scrapes the movie's film series from the movieconnections page. If the movie is the series' first part,
+// the series is named after the movie's original title.
+func (r *Controller) scrapeConnections(movie *tags.Movie) error {
+	global.Log.Debug("Scraping movie connections page")
+	body, err := r.fetchPage(r.ConnectionsURL())
+	if err != nil {
+		return fmt.Errorf("Movie connections: Could not fetch page: %s", err)
+	}
+	page, err := NewConnections(body)
+	if err != nil {
+		return fmt.Errorf("Movie connections: Could not parse document: %s", err)
+	}
+	series, err := page.Series()
+	if err != nil {
+		return fmt.Errorf("Movie connections: %s", err)
+	}
+	title := r.o.CollectionTitle
+	if title == "" {
+		title = series.Title
+	}
+	if title == "" {
+		switch {
+		case movie.Original != nil && len(movie.Original.Titles) > 0:
+			title = movie.Original.Titles[0].Text
+		case len(movie.Titles) > 0:
+			title = movie.Titles[0].Text
+		default:
+			return errors.New("Movie connections: Could not determine the title of the film series")
+		}
+	}
+	global.Log.Debugf("scrapeConnections: Part %d of %d in series %q", series.PartNumber, series.TotalParts, title)
+	movie.Collection = &tags.Collection{
+		Title:      tags.UniLingual(title),
+		TotalParts: tags.UniLingual(strconv.Itoa(series.TotalParts)),
+	}
+	movie.PartNumber = tags.UniLingual(strconv.Itoa(series.PartNumber))
+	return nil
+}
+
+// Fetches the title page in the given language and adds its localized titles, synopses and genres to the movie.
+func (r *Controller) scrapeLocalizedTitlePage(movie *tags.Movie, lang *lcconv.LngCntry) error {
+	global.Log.Debugf("Scraping title page in language %s", lang.HttpHeader())
+	body := new(bytes.Buffer)
+	if err := ihttp.GetBody(nil, r.o.UserAgent, r.localizedTitleURL(lang), body, lang); err != nil {
+		return err
+	}
+	return r.mergeLocalizedTitlePage(movie, body, lang)
+}
+
+// Returns the URL of the title page in the given language. As IMDB localizes pages by the URL's locale
+// regardless of Accept-Language, the locale is replaced by the one of lang or dropped if IMDB has none.
+func (r *Controller) localizedTitleURL(lang *lcconv.LngCntry) string {
+	if r.urlCountry == "" {
+		return r.TitleURL()
+	}
+	return r.titleURL(urlLocale(lang))
+}
+
+// Adds the titles, synopses and genres of the title page in the given language read from body to the movie.
+// Texts that the movie already contains in another language are skipped as IMDB falls back to english
+// for untranslated texts.
+func (r *Controller) mergeLocalizedTitlePage(movie *tags.Movie, body io.Reader, lang *lcconv.LngCntry) error {
+	title, err := NewTitle(r, body)
+	if err != nil {
+		return err
+	}
+	title.lang = lang
+	localized := new(tags.Movie)
+	localized.SetFieldCallback("Genres", title.Genres)
+	if r.o.UseInterests {
+		localized.SetFieldCallback("Interests", title.Interests)
+	}
+	localized.SetFieldCallback("Synopses", title.Synopsis)
+	localized.SetFieldCallback("Titles", title.Title)
+	movie.Genres = mergeLocalized(movie.Genres, localized.Genres)
+	movie.Interests = mergeLocalized(movie.Interests, localized.Interests)
+	movie.Synopses = mergeLocalized(movie.Synopses, localized.Synopses)
+	movie.Titles = mergeLocalized(movie.Titles, localized.Titles)
 	return nil
 }
 
+// Identifies the language of each text. Depending on option "langid", texts whose language differs from their
+// language tag are either relabeled or dropped. Texts that cannot be identified reliably are kept as they are.
+func (r *Controller) checkLanguages(texts []tags.MultiLingual) []tags.MultiLingual {
+	checked := make([]tags.MultiLingual, 0, len(texts))
+	for _, text := range texts {
+		if !slices.Contains(langid.Languages(), text.Lang) {
+			checked = append(checked, text)
+			continue
+		}
+		lang, err := langid.Identify(text.Text)
+		if err != nil {
+			global.Log.Debugf("checkLanguages: Keeping %q: %s", text.Text, err)
+		} else if lang != text.Lang {
+			if r.o.LangID == "drop" {
+				global.Log.Infof("Dropping %q: Expected language %q, identified %q", text.Text, text.Lang, lang)
+				continue
+			}
+			global.Log.Infof("Correcting language of %q from %q to %q", text.Text, text.Lang, lang)
+			text.Lang = lang
+		}
+		checked = append(checked, text)
+	}
+	return checked
+}
+
+// Translates genres into the language with the given ISO-639-1 code.
+// Genres without a known translation keep their text and language.
+func translateGenres(genres []tags.MultiLingual, lang string) []tags.MultiLingual {
+	translated := make([]tags.MultiLingual, 0, len(genres))
+	for _, g := range genres {
+		if text, ok := genre.Translate(g.Text, lang); ok {
+			g = tags.MultiLingual{Text: text, Lang: lang}
+		}
+		if !slices.Contains(translated, g) {
+			translated = append(translated, g)
+		}
+	}
+	return translated
+}
+
+// Replaces genres by their names in m, genres mapped to an empty name are dropped.
+func mapGenres(genres []tags.MultiLingual, m genre.Mapping) []tags.MultiLingual {
+	mapped := make([]tags.MultiLingual, 0, len(genres))
+	for _, g := range genres {
+		if name, ok := m.Map(g.Text); ok {
+			if name == "" {
+				global.Log.Debugf("Dropping genre %q", g.Text)
+				continue
+			}
+			g.Text = name
+		}
+		if !slices.Contains(mapped, g) {
+			mapped = append(mapped, g)
+		}
+	}
+	return mapped
+}
+
+// Keeps the first limit texts of each language, all texts are kept if limit is not positive.
+func limitPerLanguage(texts []tags.MultiLingual, limit int) []tags.MultiLingual {
+	if limit < 1 {
+		return texts
+	}
+	counts := make(map[string]int)
+	limited := make([]tags.MultiLingual, 0, len(texts))
+	for _, text := range texts {
+		if counts[text.Lang] < limit {
+			limited = append(limited, text)
+			counts[text.Lang]++
+		}
+	}
+	return limited
+}
+
+// Appends the interests to the keywords, interests that are already keywords are skipped.
+func mergeInterests(keywords, interests []tags.MultiLingual) []tags.MultiLingual {
+	for _, interest := range interests {
+		if !slices.ContainsFunc(keywords, func(k tags.MultiLingual) bool { return strings.EqualFold(k.Text, interest.Text) }) {
+			keywords = append(keywords, interest)
+		}
+	}
+	return keywords
+}
+
+// Reads the genre mapping file at path.
+func readGenreMap(path string) (genre.Mapping, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return genre.ReadMapping(f)
+}
+
+// Appends all localized texts whose text is not already part of texts.
+func mergeLocalized(texts, localized []tags.MultiLingual) []tags.MultiLingual {
+	for _, text := range localized {
+		if !slices.ContainsFunc(texts, func(t tags.MultiLingual) bool { return t.Text == text.Text }) {
+			texts = append(texts, text)
+		}
+	}
+	return texts
+}
+
+// Scrapes the title page's box office section, also if the title page's json-ld data is in use.
+func (r *Controller) scrapeBoxOffice(movie *tags.Movie, titlePage []byte) error {
+	global.Log.Debug("Scraping box office section")
+	title, err := NewTitle(r, bytes.NewReader(titlePage))
+	if err != nil {
+		return err
+	}
+	movie.SetFieldCallback("BoxOffice", title.BoxOffice)
+	return nil
+}
+
+func (r *Controller) scrapeParentalGuide(movie *tags.Movie) error {
+	global.Log.Debug("Scraping parental guide page")
+	body, err := r.fetchPage(r.ParentalGuideURL())
+	if err != nil {
+		return fmt.Errorf("Parental guide: Could not fetch page: %s", err)
+	}
+	guide, err := NewParentalGuide(body)
+	if err != nil {
+		return fmt.Errorf("Parental guide: Could not parse document: %s", err)
+	}
+	if r.o.UseAdvisories {
+		movie.SetFieldCallback("ParentalGuide", guide.Advisories)
+	}
+	certs, err := guide.Certificates()
+	if err != nil {
+		return fmt.Errorf("Parental guide: %s", err)
+	}
+	certified := new(tags.Movie)
+	for _, cert := range certs {
+		if len(r.o.CertificateCountries) > 0 && !slices.Contains(r.o.CertificateCountries, cert.Country.Alpha2()) {
+			continue
+		}
+		country := certified.Country(cert.Country.Alpha3())
+		if !country.IsEmpty() {
+			global.Log.Debugf("Parental guide: Skipping additional certificate %q for country %s", cert.Rating, cert.Country.Alpha2())
+			continue
+		}
+		country.SetFieldCallback("LawRating", func() (tags.UniLingual, error) {
+			return tags.UniLingual(cert.Rating), nil
+		})
+	}
+	if len(certified.Countries) < 1 {
+		return errors.New("Parental guide: No applicable certificates found")
+	}
+	// The certificates replace the country tag derived from the title page
+	// as the latter cannot tell which country its rating belongs to.
+	movie.Countries = certified.Countries
+	return nil
+}
+
+// Returns all distributors whose annotations refer to the preferred language's country.
+func (r *Controller) filterDistributors(companies []Company) []Company {
+	filtered := make([]Company, 0, len(companies))
+	for _, company := range companies {
+		if cc := company.Country(); cc.IsValid() && cc.Alpha2() == r.PreferredLang().Alpha2() {
+			filtered = append(filtered, company)
+		}
+	}
+	return filtered
+}
+
+// Fetches the page at url with the controller's language settings.
+func (r *Controller) fetchPage(url string) (*bytes.Buffer, error) {
+	body := new(bytes.Buffer)
+	if err := ihttp.GetBody(nil, r.o.UserAgent, url, body, r.lang...); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
 func (r *Controller) scrapeKeywordPage(movie *tags.Movie) error {
 	// Parse keyword page
 	global.Log.Debug("Scraping keyword page")
@@ -259,7 +1262,7 @@ func (r *Controller) scrapeKeywordPage(movie *tags.Movie) error {
 	keywordsTag := make([]tags.MultiLingual, limit)
 	for i := 0; i < limit; i++ {
 		keywordsTag[i].Text = keywords[i].Name
-		keywordsTag[i].Lang = r.DefaultLang().ISO6391() // At the moment keywords are in english only, if IMDB changes that, it must also be changed here to PreferredLanguage().
+		keywordsTag[i].Lang = r.DefaultLang().Language() // At the moment keywords are in english only, if IMDB changes that, it must also be changed here to PreferredLanguage().
 	}
 	global.Log.Debug(fmt.Sprintf("scrapeKeywordPage: Adding %d keywords", len(keywordsTag)))
 	// Deploy the keyword tags to the movie object
@@ -282,12 +1285,35 @@ func (r *Controller) scrapeTitlePage(src io.Reader) (*tags.Movie, error) {
 	movie.SetFieldCallback("Synopses", title.Synopsis)
 	movie.SetFieldCallback("Titles", title.Title)
 	movie.SetFieldCallback("Writers", title.Writers)
+	if r.o.UseInterests {
+		movie.SetFieldCallback("Interests", title.Interests)
+	}
+	// Without an original title line the displayed title is the original one
+	originalTitle, err := title.OriginalTitle()
+	if err != nil {
+		global.Log.Debugf("Assuming displayed title to be the original title: %s", err)
+	}
+	movie.SetFieldCallback("Original", func() (*tags.Original, error) {
+		return title.Original(originalTitle)
+	})
+	if r.o.UseRatings {
+		movie.SetFieldCallback("Ratings", title.Ratings)
+	}
+	if originalTitle == "" && movie.Original != nil {
+		for i := range movie.Titles {
+			movie.Titles[i].Lang = movie.Original.Titles[0].Lang
+		}
+	}
 
-	country := &tags.Country{Name: r.PreferredLang().Alpha3()}
-	country.SetFieldCallback("LawRating", title.LawRating)
-
-	if !country.IsEmpty() {
-		movie.Countries = []*tags.Country{country}
+	// The title page's law rating applies to the country of the preferred language
+	if r.PreferredLang().Alpha3() == "" {
+		global.Log.Warningf("Preferred language %s has no country, the title page's law rating is omitted", r.PreferredLang())
+	} else {
+		country := &tags.Country{Name: r.PreferredLang().Alpha3()}
+		country.SetFieldCallback("LawRating", title.LawRating)
+		if !country.IsEmpty() {
+			movie.Countries = []*tags.Country{country}
+		}
 	}
 
 	return movie, nil
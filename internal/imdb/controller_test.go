@@ -0,0 +1,165 @@
+//This file is part of imdb2mkvtags ©2026 Jörg Walter
+
+package imdb
+
+import (
+	"github.com/jwdev42/imdb2mkvtags/internal/cmdline"
+	"github.com/jwdev42/imdb2mkvtags/internal/lcconv"
+	"github.com/jwdev42/imdb2mkvtags/internal/tags"
+	"slices"
+	"testing"
+)
+
+func TestTitleURLLocale(t *testing.T) {
+	tests := []struct {
+		url, lang, expected string
+	}{
+		{"https://www.imdb.com/de/title/tt0096895/", "", "https://imdb.com/de/title/tt0096895"},
+		{"https://www.imdb.com/de/title/tt0096895/", "de-DE", "https://imdb.com/de/title/tt0096895"},
+		{"https://www.imdb.com/de/title/tt0096895/", "en-US", "https://imdb.com/title/tt0096895"},
+		{"https://www.imdb.com/de/title/tt0096895/", "de-AT", "https://imdb.com/title/tt0096895"},
+		{"https://www.imdb.com/title/tt0096895/", "de-DE", "https://imdb.com/title/tt0096895"},
+	}
+	for _, test := range tests {
+		c, err := NewController(test.url)
+		if err != nil {
+			t.Fatal(err)
+		}
+		userAgent := ""
+		flags := &cmdline.Flags{UserAgent: &userAgent}
+		if test.lang != "" {
+			lang, err := lcconv.NewLngCntry(test.lang)
+			if err != nil {
+				t.Fatal(err)
+			}
+			flags.Lang = []*lcconv.LngCntry{lang}
+		}
+		if err := c.SetOptions(flags); err != nil {
+			t.Fatal(err)
+		}
+		if res := c.TitleURL(); res != test.expected {
+			t.Errorf("TitleURL(%q, %q): Expected %q, got %q", test.url, test.lang, test.expected, res)
+		}
+	}
+}
+
+func TestLocalizedTitleURL(t *testing.T) {
+	tests := []struct {
+		url, lang, expected string
+	}{
+		{"https://www.imdb.com/de/title/tt0096895/", "de-DE", "https://imdb.com/de/title/tt0096895"},
+		{"https://www.imdb.com/de/title/tt0096895/", "fr-FR", "https://imdb.com/fr/title/tt0096895"},
+		{"https://www.imdb.com/de/title/tt0096895/", "en-GB", "https://imdb.com/title/tt0096895"},
+		{"https://www.imdb.com/title/tt0096895/", "fr-FR", "https://imdb.com/title/tt0096895"},
+	}
+	for _, test := range tests {
+		c, err := NewController(test.url)
+		if err != nil {
+			t.Fatal(err)
+		}
+		lang, err := lcconv.NewLngCntry(test.lang)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res := c.localizedTitleURL(lang); res != test.expected {
+			t.Errorf("localizedTitleURL(%q, %q): Expected %q, got %q", test.url, test.lang, test.expected, res)
+		}
+	}
+}
+
+func TestMergeLocalizedTitlePage(t *testing.T) {
+	c, err := NewController("imdb://tt0096895")
+	if err != nil {
+		t.Fatal(err)
+	}
+	lang, err := lcconv.NewLngCntry("de-DE")
+	if err != nil {
+		t.Fatal(err)
+	}
+	movie := &tags.Movie{
+		Genres:    []tags.MultiLingual{{Text: "Adventure", Lang: "en"}, {Text: "Crime", Lang: "en"}},
+		Interests: []tags.MultiLingual{{Text: "Superhero", Lang: "en"}},
+		Synopses:  []tags.MultiLingual{{Text: "The Dark Knight of Gotham City begins his war on crime.", Lang: "en"}},
+		Titles:    []tags.MultiLingual{{Text: "Batman", Lang: "en"}},
+	}
+	if err := c.mergeLocalizedTitlePage(movie, openFixture(t, "title-de.html"), lang); err != nil {
+		t.Fatal(err)
+	}
+	tests := map[string]struct {
+		res, expected []tags.MultiLingual
+	}{
+		"Genres": {movie.Genres, []tags.MultiLingual{
+			{Text: "Adventure", Lang: "en"},
+			{Text: "Crime", Lang: "en"},
+			{Text: "Abenteuer", Lang: "de"},
+		}},
+		"Interests": {movie.Interests, []tags.MultiLingual{
+			{Text: "Superhero", Lang: "en"},
+			{Text: "Superheld", Lang: "de"},
+		}},
+		"Synopses": {movie.Synopses, []tags.MultiLingual{
+			{Text: "The Dark Knight of Gotham City begins his war on crime.", Lang: "en"},
+			{Text: "Der Dunkle Ritter von Gotham City beginnt seinen Krieg gegen das Verbrechen, als sein erster Gegner der Joker ist.", Lang: "de"},
+		}},
+		"Titles": {movie.Titles, []tags.MultiLingual{{Text: "Batman", Lang: "en"}}},
+	}
+	for field, test := range tests {
+		if !slices.Equal(test.res, test.expected) {
+			t.Errorf("mergeLocalizedTitlePage: Expected %s %q, got %q", field, test.expected, test.res)
+		}
+	}
+}
+
+func TestParseCrew(t *testing.T) {
+	tests := map[string][]string{
+		"editor":                   {"editor"},
+		"editor,composer,editor":   {"editor", "composer"},
+		"sound,sound,editor,sound": {"sound", "editor"},
+	}
+	for value, expected := range tests {
+		if res, err := parseCrew(value); err != nil {
+			t.Errorf("parseCrew(%q): %s", value, err)
+		} else if !slices.Equal(res, expected) {
+			t.Errorf("parseCrew(%q): Expected %q, got %q", value, expected, res)
+		}
+	}
+	if res, err := parseCrew("editor,writer"); err == nil {
+		t.Errorf("parseCrew(%q): Expected error, got %q", "editor,writer", res)
+	}
+}
+
+func TestParseCompanies(t *testing.T) {
+	tests := map[string][]string{
+		"production":                         {"production"},
+		"production,distribution,production": {"production", "distribution"},
+	}
+	for value, expected := range tests {
+		if res, err := parseCompanies(value); err != nil {
+			t.Errorf("parseCompanies(%q): %s", value, err)
+		} else if !slices.Equal(res, expected) {
+			t.Errorf("parseCompanies(%q): Expected %q, got %q", value, expected, res)
+		}
+	}
+	if res, err := parseCompanies("production,studio"); err == nil {
+		t.Errorf("parseCompanies(%q): Expected error, got %q", "production,studio", res)
+	}
+}
+
+func TestSameLanguage(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected bool
+	}{
+		{"de", "de", true},
+		{"de-DE", "de", true},
+		{"zh-Hant-TW", "zh", true},
+		{"de-AT", "en", false},
+		{"", "de", false},
+		{"", "", true},
+	}
+	for _, test := range tests {
+		if res := sameLanguage(test.a, test.b); res != test.expected {
+			t.Errorf("sameLanguage(%q, %q): Expected %t, got %t", test.a, test.b, test.expected, res)
+		}
+	}
+}
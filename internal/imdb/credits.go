@@ -8,14 +8,48 @@ import (
 	"golang.org/x/net/html"
 	"golang.org/x/net/html/atom"
 	"io"
+	"net/url"
 	"os"
 	"regexp"
 	"slices"
+	"strconv"
 	"strings"
 )
 
 var matchNameLink = regexp.MustCompile("name\\/nm")
-var matchCharacterLink = regexp.MustCompile("characters\\/nm")
+var regexpNameID = regexp.MustCompile("nm\\d+")
+var matchCharacterLink = regexp.MustCompile("characters\\/nm|character\\/ch")
+var regexpCharacterID = regexp.MustCompile("ch\\d+")
+var regexpCharacterDelim = regexp.MustCompile("\\s+/\\s+")
+var regexpEpisodes = regexp.MustCompile("(?i)\\b(\\d+)\\s+(?:episodes?|eps?)\\b")
+
+// Describes a crew category that can be selected by option "crew".
+type crewCategory struct {
+	field  string // Field of tags.Movie that receives the names
+	anchor string // IMDB's anchor name for the credit group on the fullcredits page
+}
+
+// Maps the values of option "crew" to their crew categories.
+var crewCategories = map[string]crewCategory{
+	"art-director":        {field: "ArtDirectors", anchor: "art_director"},
+	"assistant-director":  {field: "AssistantDirectors", anchor: "assistant_director"},
+	"cinematographer":     {field: "Cinematographers", anchor: "cinematographer"},
+	"composer":            {field: "Composers", anchor: "composer"},
+	"costume-designer":    {field: "CostumeDesigners", anchor: "costume_designer"},
+	"editor":              {field: "Editors", anchor: "editor"},
+	"production-designer": {field: "ProductionDesigners", anchor: "production_designer"},
+	"sound":               {field: "SoundEngineers", anchor: "sound_department"},
+}
+
+// Returns the names of all crew categories in alphabetical order.
+func crewCategoryNames() []string {
+	names := make([]string, 0, len(crewCategories))
+	for name := range crewCategories {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
 
 // represents "fullcredits" pages https://www.imdb.com/title/$titleID/fullcredits
 type Credits struct {
@@ -43,7 +77,7 @@ func (r *Credits) Actors() ([]tags.Actor, error) {
 	actors := make([]tags.Actor, 0, 100)
 	rows := rottensoup.ElementsByTag(table, atom.Li)
 	for i, row := range rows {
-		actor, err := r.actor(row)
+		actor, err := r.actor(row, len(actors)+1)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Cast table row %d: %s\n", i+1, err)
 			continue
@@ -56,19 +90,32 @@ func (r *Credits) Actors() ([]tags.Actor, error) {
 	return actors, nil
 }
 
-func (r *Credits) Directors() ([]tags.UniLingual, error) {
-	return r.scrapeUnilingualSubSection("sub-section-amzn1.imdb.concept.name_credit_category.ace5cb4c-8708-4238-9542-04641e7c8171")
+func (r *Credits) Directors() ([]*tags.Person, error) {
+	return r.scrapeSubSection("sub-section-amzn1.imdb.concept.name_credit_category.ace5cb4c-8708-4238-9542-04641e7c8171")
 }
 
-func (r *Credits) Producers() ([]tags.UniLingual, error) {
-	return r.scrapeUnilingualSubSection("sub-section-amzn1.imdb.concept.name_credit_category.0af123ce-1605-4a51-93cf-7ad477b11832")
+func (r *Credits) Producers() ([]*tags.Person, error) {
+	return r.scrapeSubSection("sub-section-amzn1.imdb.concept.name_credit_category.0af123ce-1605-4a51-93cf-7ad477b11832")
 }
 
-func (r *Credits) Writers() ([]tags.UniLingual, error) {
-	return r.scrapeUnilingualSubSection("sub-section-amzn1.imdb.concept.name_credit_category.c84ecaff-add5-4f2e-81db-102a41881fe3")
+func (r *Credits) Writers() ([]*tags.Person, error) {
+	return r.scrapeSubSection("sub-section-amzn1.imdb.concept.name_credit_category.c84ecaff-add5-4f2e-81db-102a41881fe3")
 }
 
-func (r *Credits) actor(entry *html.Node) (*tags.Actor, error) {
+// Scrapes the persons listed in the credit group with the given anchor name, e.g. "composer".
+func (r *Credits) Crew(anchor string) ([]*tags.Person, error) {
+	section := sectionByAnchor(r.root, anchor)
+	if section == nil {
+		return nil, fmt.Errorf("No credit group %q found", anchor)
+	}
+	persons := r.persons(section)
+	if len(persons) < 1 {
+		return nil, fmt.Errorf("Could not extract any names from credit group %q", anchor)
+	}
+	return persons, nil
+}
+
+func (r *Credits) actor(entry *html.Node, billing int) (*tags.Actor, error) {
 	getLinkText := func(parent *html.Node) (string, error) {
 		text := parent.FirstChild
 		if text == nil || text.Type != html.TextNode {
@@ -91,73 +138,141 @@ func (r *Credits) actor(entry *html.Node) (*tags.Actor, error) {
 	if err != nil {
 		return nil, fmt.Errorf("Failed to extract actor string: %s", err)
 	}
-	actor = &tags.Actor{Name: actorName}
+	actor = &tags.Actor{Person: *newPerson(actorName, actorMatches[1], billing)}
+	actor.Notes = creditNotes(entry)
+
+	actor.Episodes = episodeCount(entry)
 
-	//Get actor's character
+	//Get actor's characters
 	characterMatches := rottensoup.ElementsByAttrMatch(entry, "", "href", matchCharacterLink)
 	if characterMatches == nil {
 		return actor, fmt.Errorf("No character found for actor %q", actorName)
 	}
-	characterName, err := getLinkText(characterMatches[0])
-	if err != nil {
-		return actor, fmt.Errorf("Failed to extract character string for actor %q: %s", actorName, err)
+	actor.Characters = characters(characterMatches)
+	if len(actor.Characters) < 1 {
+		return actor, fmt.Errorf("Failed to extract character string for actor %q", actorName)
 	}
-	actor.Character = characterName
 	return actor, nil
 }
 
-func (r *Credits) scrapeUnilingualSubSection(testID string) ([]tags.UniLingual, error) {
-	texts, err := r.scrapeTextsFromSubSection(testID)
-	if err != nil {
-		return nil, err
+func (r *Credits) scrapeSubSection(testID string) ([]*tags.Person, error) {
+	subSection := r.elementByTestID(testID)
+	if subSection == nil {
+		return nil, fmt.Errorf("No subsection %q found", testID)
 	}
-	uniLingualTexts := make([]tags.UniLingual, len(texts))
-	for i, text := range texts {
-		uniLingualTexts[i] = tags.UniLingual(text)
+	persons := r.persons(subSection)
+	if len(persons) < 1 {
+		return nil, fmt.Errorf("Could not extract any names from subsection %q", testID)
 	}
-	return uniLingualTexts, nil
+	return persons, nil
 }
 
-func (r *Credits) scrapeTextsFromSubSection(testID string) ([]string, error) {
-	links, err := r.nameLinksFromSubSection(testID)
-	if err != nil {
-		return nil, err
+// Scrapes all persons linked below node in the order of the credits. Links whose first child is
+// not a text node, e.g. images, are skipped. A person credited more than once is only returned once,
+// the notes of all credits are merged.
+func (r *Credits) persons(node *html.Node) []*tags.Person {
+	links := rottensoup.ElementsByAttrMatch(node, "", "href", matchNameLink)
+	persons := make([]*tags.Person, 0, len(links))
+	for _, link := range links {
+		child := link.FirstChild
+		if child == nil || child.Type != html.TextNode || strings.TrimSpace(child.Data) == "" {
+			continue
+		}
+		person := newPerson(strings.TrimSpace(child.Data), link, len(persons)+1)
+		var notes []tags.UniLingual
+		if item := enclosingListItem(link); item != nil {
+			notes = creditNotes(item)
+		}
+		i := slices.IndexFunc(persons, func(p *tags.Person) bool {
+			return p.Name == person.Name && p.ID == person.ID
+		})
+		if i < 0 {
+			person.Notes = notes
+			persons = append(persons, person)
+		} else {
+			persons[i].Notes = mergeNames(persons[i].Notes, notes)
+		}
 	}
-	texts := r.extractTextChildren(links)
-	if texts == nil {
-		return nil, fmt.Errorf("Could not extract any text nodes from subsection %q", testID)
+	return persons
+}
+
+func (r *Credits) elementByTestID(testID string) *html.Node {
+	return rottensoup.FirstElementByAttr(r.root, html.Attribute{Key: "data-testid", Val: testID})
+}
+
+// Creates a person from the given name and the name ID of link's URL, e.g. "/name/nm0000216/?ref_=ttfc_fc_cl_t1".
+// If link is nil, the person has no name ID.
+func newPerson(name string, link *html.Node, billing int) *tags.Person {
+	person := &tags.Person{
+		Name:    name,
+		Billing: tags.UniLingual(strconv.Itoa(billing)),
+	}
+	if link == nil {
+		return person
 	}
-	return slices.Compact(texts), nil
+	if id := regexpNameID.FindString(rottensoup.AttrVal(link, "", "href")); id != "" {
+		person.ID = tags.UniLingual(id)
+		person.URL = tags.UniLingual(nameURL(id))
+	}
+	return person
 }
 
-func (r *Credits) nameLinksFromSubSection(testID string) ([]*html.Node, error) {
-	subSection := r.elementByTestID(testID)
-	if subSection == nil {
-		return nil, fmt.Errorf("No subsection %q found", testID)
+// Returns the URL of the IMDB page of the person with the given name ID.
+func nameURL(id string) string {
+	return fmt.Sprintf("https://www.imdb.com/name/%s/", url.PathEscape(id))
+}
+
+// Scrapes the characters from the given character links. A link's text may name several roles
+// like "Peter Parker / Spider-Man", each role is returned as separate character.
+func characters(links []*html.Node) []*tags.Character {
+	characters := make([]*tags.Character, 0, len(links))
+	for _, link := range links {
+		href := rottensoup.AttrVal(link, "", "href")
+		for _, name := range regexpCharacterDelim.Split(strings.TrimSpace(nodeText(link)), -1) {
+			if name = strings.TrimSpace(name); name == "" {
+				continue
+			}
+			character := &tags.Character{Name: name, ID: tags.UniLingual(regexpCharacterID.FindString(href))}
+			if u, err := imdbURL(href); err == nil {
+				character.URL = tags.UniLingual(u)
+			}
+			characters = append(characters, character)
+		}
 	}
-	return rottensoup.ElementsByAttrMatch(subSection, "", "href", matchNameLink), nil
+	return characters
 }
 
-// Reads the first child of each element in element. If it is a text node,
-// its text will be appended to the output slice.
-// Returns nil if elements is nil or empty.
-func (r *Credits) extractTextChildren(elements []*html.Node) []string {
-	if elements == nil || len(elements) == 0 {
-		return nil
+// Returns the episode count of a series' credits entry like "(10 episodes, 2019-2021)" or "10 eps".
+// Returns an empty string if the entry does not state an episode count.
+func episodeCount(entry *html.Node) tags.UniLingual {
+	if matches := regexpEpisodes.FindStringSubmatch(nodeText(entry)); matches != nil {
+		return tags.UniLingual(matches[1])
 	}
-	texts := make([]string, 0, len(elements))
-	for _, element := range elements {
-		child := element.FirstChild
-		if child == nil {
-			continue
-		}
-		if child.Type == html.TextNode && child.Data != "" {
-			texts = append(texts, child.Data)
-		}
+	return ""
+}
+
+// Resolves href against IMDB's base URL, the query is dropped.
+func imdbURL(href string) (string, error) {
+	ref, err := url.Parse(href)
+	if err != nil {
+		return "", err
 	}
-	return texts
+	base := &url.URL{Scheme: "https", Host: "www.imdb.com", Path: "/"}
+	u := base.ResolveReference(ref)
+	u.RawQuery = ""
+	return u.String(), nil
 }
 
-func (r *Credits) elementByTestID(testID string) *html.Node {
-	return rottensoup.FirstElementByAttr(r.root, html.Attribute{Key: "data-testid", Val: testID})
+// Returns the parenthesized annotations of a credits entry, e.g. "uncredited" or "voice".
+// Episode counts are not considered annotations.
+func creditNotes(entry *html.Node) []tags.UniLingual {
+	matches := matchParenthesized.FindAllStringSubmatch(nodeText(entry), -1)
+	notes := make([]tags.UniLingual, 0, len(matches))
+	for _, match := range matches {
+		if regexpEpisodes.MatchString(match[1]) {
+			continue
+		}
+		notes = mergeNames(notes, []tags.UniLingual{tags.UniLingual(strings.TrimSpace(match[1]))})
+	}
+	return notes
 }
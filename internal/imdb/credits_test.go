@@ -0,0 +1,165 @@
+//This file is part of imdb2mkvtags ©2026 Jörg Walter
+
+package imdb
+
+import (
+	"github.com/jwdev42/imdb2mkvtags/internal/tags"
+	"golang.org/x/net/html"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// Opens the HTML fixture with the given name from the testdata directory.
+func openFixture(t *testing.T, name string) *os.File {
+	t.Helper()
+	f, err := os.Open(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { f.Close() })
+	return f
+}
+
+func TestCrew(t *testing.T) {
+	credits, err := NewCredits(openFixture(t, "fullcredits.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := map[string][]string{
+		"art_director":        {"Leslie Tomkins", "Terry Ackland-Snow"},
+		"assistant_director":  {"Derek Cracknell"},
+		"cinematographer":     {"Roger Pratt"},
+		"composer":            {"Danny Elfman"},
+		"costume_designer":    {"Bob Ringwood"},
+		"editor":              {"Ray Lovejoy"},
+		"production_designer": {"Anton Furst"},
+		"sound_department":    {"Don Sharpe"},
+	}
+	for name, category := range crewCategories {
+		if _, ok := tests[category.anchor]; !ok {
+			t.Errorf("Crew category %q: Anchor %q is not covered by the fixture", name, category.anchor)
+		}
+	}
+	for anchor, expected := range tests {
+		persons, err := credits.Crew(anchor)
+		if err != nil {
+			t.Errorf("Crew(%q): %s", anchor, err)
+			continue
+		}
+		names := make([]string, len(persons))
+		for i, person := range persons {
+			names[i] = person.Name
+		}
+		if !slices.Equal(names, expected) {
+			t.Errorf("Crew(%q): Expected %q, got %q", anchor, expected, names)
+		}
+	}
+	if persons, err := credits.Crew("stunts"); err == nil {
+		t.Errorf("Crew(\"stunts\"): Expected error, got %d persons", len(persons))
+	}
+}
+
+func TestPersons(t *testing.T) {
+	credits, err := NewCredits(openFixture(t, "fullcredits.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := map[string]struct {
+		scrape   func() ([]*tags.Person, error)
+		expected []tags.Person
+	}{
+		"Directors": {credits.Directors, []tags.Person{
+			{Name: "Tim Burton", Billing: "1", ID: "nm0000318", Notes: []tags.UniLingual{}, URL: "https://www.imdb.com/name/nm0000318/"},
+		}},
+		"Writers": {credits.Writers, []tags.Person{
+			{Name: "Bob Kane", Billing: "1", ID: "nm0004170", Notes: []tags.UniLingual{"Batman characters"}, URL: "https://www.imdb.com/name/nm0004170/"},
+			{Name: "Sam Hamm", Billing: "2", ID: "nm0357436", Notes: []tags.UniLingual{"story", "screenplay"}, URL: "https://www.imdb.com/name/nm0357436/"},
+			{Name: "Warren Skaaren", Billing: "3", ID: "nm0803225", Notes: []tags.UniLingual{"screenplay"}, URL: "https://www.imdb.com/name/nm0803225/"},
+		}},
+		"Crew(composer)": {func() ([]*tags.Person, error) { return credits.Crew("composer") }, []tags.Person{
+			{Name: "Danny Elfman", Billing: "1", ID: "nm0000384", Notes: []tags.UniLingual{"music by", "score"}, URL: "https://www.imdb.com/name/nm0000384/"},
+		}},
+	}
+	for name, test := range tests {
+		persons, err := test.scrape()
+		if err != nil {
+			t.Errorf("%s: %s", name, err)
+			continue
+		}
+		if len(persons) != len(test.expected) {
+			t.Errorf("%s: Expected %d persons, got %d", name, len(test.expected), len(persons))
+			continue
+		}
+		for i, person := range persons {
+			if !reflect.DeepEqual(*person, test.expected[i]) {
+				t.Errorf("%s[%d]: Expected %+v, got %+v", name, i, test.expected[i], *person)
+			}
+		}
+	}
+	if _, err := credits.Producers(); err == nil {
+		t.Error("Producers: Expected error")
+	}
+}
+
+func TestActors(t *testing.T) {
+	credits, err := NewCredits(openFixture(t, "fullcredits.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []tags.Actor{
+		{
+			Person: tags.Person{Name: "Michael Keaton", Billing: "1", ID: "nm0000474", Notes: []tags.UniLingual{}, URL: "https://www.imdb.com/name/nm0000474/"},
+			Characters: []*tags.Character{
+				{Name: "Bruce Wayne", URL: "https://www.imdb.com/title/tt0096895/characters/nm0000474/"},
+				{Name: "Batman", URL: "https://www.imdb.com/title/tt0096895/characters/nm0000474/"},
+			},
+		},
+		{
+			Person: tags.Person{Name: "Jack Nicholson", Billing: "2", ID: "nm0000197", Notes: []tags.UniLingual{}, URL: "https://www.imdb.com/name/nm0000197/"},
+			Characters: []*tags.Character{
+				{Name: "Joker", ID: "ch0000180", URL: "https://www.imdb.com/character/ch0000180/"},
+				{Name: "Jack Napier", URL: "https://www.imdb.com/title/tt0096895/characters/nm0000197/"},
+			},
+		},
+		{
+			Person:     tags.Person{Name: "Adam West", Billing: "3", ID: "nm0001845", Notes: []tags.UniLingual{"voice", "uncredited"}, URL: "https://www.imdb.com/name/nm0001845/"},
+			Characters: []*tags.Character{{Name: "Gotham Mayor", URL: "https://www.imdb.com/title/tt0096895/characters/nm0001845/"}},
+		},
+	}
+	actors, err := credits.Actors()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(actors, expected) {
+		t.Errorf("Actors: Expected %+v, got %+v", expected, actors)
+	}
+}
+
+func TestCreditAnnotations(t *testing.T) {
+	tests := map[string]struct {
+		episodes tags.UniLingual
+		notes    []tags.UniLingual
+	}{
+		"(10 episodes, 2019-2021)":         {"10", []tags.UniLingual{}},
+		"1 episode":                        {"1", []tags.UniLingual{}},
+		"(voice) (24 eps)":                 {"24", []tags.UniLingual{"voice"}},
+		"(uncredited)":                     {"", []tags.UniLingual{"uncredited"}},
+		"(as Jack Nicholson) (uncredited)": {"", []tags.UniLingual{"as Jack Nicholson", "uncredited"}},
+	}
+	for text, expected := range tests {
+		root, err := html.Parse(strings.NewReader("<li>" + text + "</li>"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res := episodeCount(root); res != expected.episodes {
+			t.Errorf("episodeCount(%q): Expected %q, got %q", text, expected.episodes, res)
+		}
+		if res := creditNotes(root); !slices.Equal(res, expected.notes) {
+			t.Errorf("creditNotes(%q): Expected %q, got %q", text, expected.notes, res)
+		}
+	}
+}
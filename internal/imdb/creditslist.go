@@ -6,13 +6,14 @@ import (
 	"errors"
 	"fmt"
 	"github.com/jwdev42/imdb2mkvtags/internal/global"
+	"github.com/jwdev42/imdb2mkvtags/internal/tags"
 	"github.com/jwdev42/rottensoup"
 	"golang.org/x/net/html"
 	"golang.org/x/net/html/atom"
 )
 
 // Represents the list containing directors, writers and stars on an imdb title page.
-type creditsList map[string][]string
+type creditsList map[string][]*tags.Person
 
 func parseCreditsList(root *html.Node) (creditsList, error) {
 	list := make(creditsList)
@@ -48,14 +49,18 @@ func parseCreditsList(root *html.Node) (creditsList, error) {
 		if entries == nil {
 			return nil, fmt.Errorf("CreditsList: No entries found for label '%s'", label)
 		}
-		data := make([]string, 0)
+		data := make([]*tags.Person, 0)
 		for i, entry := range entries {
 			text := rottensoup.FirstNodeByType(entry, html.TextNode)
 			if text == nil {
 				global.Log.Info(fmt.Errorf("CreditsList: No text at position %d for label '%s'", i, label))
 				continue
 			}
-			data = append(data, text.Data)
+			var link *html.Node
+			if links := rottensoup.ElementsByAttrMatch(entry, "", "href", matchNameLink); links != nil {
+				link = links[0]
+			}
+			data = append(data, newPerson(text.Data, link, len(data)+1))
 		}
 		if len(data) < 1 {
 			global.Log.Error(fmt.Errorf("CreditsList: No entry in credits list was applicable for label '%s'", label))
@@ -0,0 +1,44 @@
+//This file is part of imdb2mkvtags ©2026 Jörg Walter
+
+package imdb
+
+import (
+	"golang.org/x/net/html"
+	"reflect"
+	"testing"
+)
+
+func TestParseCreditsList(t *testing.T) {
+	root, err := html.Parse(openFixture(t, "title-cast.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	list, err := parseCreditsList(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := creditsList{
+		"directors": {
+			{Name: "Tim Burton", Billing: "1", ID: "nm0000318", URL: "https://www.imdb.com/name/nm0000318/"},
+		},
+		"writers": {
+			{Name: "Bob Kane", Billing: "1", ID: "nm0004170", URL: "https://www.imdb.com/name/nm0004170/"},
+			{Name: "Sam Hamm", Billing: "2"},
+			{Name: "Warren Skaaren", Billing: "3", ID: "nm0803225", URL: "https://www.imdb.com/name/nm0803225/"},
+		},
+	}
+	if len(list) != len(expected) {
+		t.Errorf("parseCreditsList: Expected labels %v, got %d labels", []string{"directors", "writers"}, len(list))
+	}
+	for label, persons := range expected {
+		if len(list[label]) != len(persons) {
+			t.Errorf("parseCreditsList: Expected %d %s, got %d", len(persons), label, len(list[label]))
+			continue
+		}
+		for i, person := range list[label] {
+			if !reflect.DeepEqual(person, persons[i]) {
+				t.Errorf("parseCreditsList: Expected %s[%d] %+v, got %+v", label, i, *persons[i], *person)
+			}
+		}
+	}
+}
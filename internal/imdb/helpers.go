@@ -0,0 +1,70 @@
+//This file is part of imdb2mkvtags ©2026 Jörg Walter
+
+package imdb
+
+import (
+	"github.com/jwdev42/imdb2mkvtags/internal/tags"
+	"github.com/jwdev42/rottensoup"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+	"slices"
+	"strings"
+)
+
+// Returns the nearest list item element that is an ancestor of node.
+// Returns nil if no such element exists.
+func enclosingListItem(node *html.Node) *html.Node {
+	for n := node.Parent; n != nil; n = n.Parent {
+		if n.Type == html.ElementNode && n.DataAtom == atom.Li {
+			return n
+		}
+	}
+	return nil
+}
+
+// Returns the section element below root that contains the element with the given id.
+// Returns nil if no such element exists.
+func sectionByAnchor(root *html.Node, anchor string) *html.Node {
+	for node := rottensoup.ElementByID(root, anchor); node != nil; node = node.Parent {
+		if node.Type == html.ElementNode && node.DataAtom == atom.Section {
+			return node
+		}
+	}
+	return nil
+}
+
+// Returns the concatenated text of all text nodes below node.
+func nodeText(node *html.Node) string {
+	var b strings.Builder
+	var collect func(*html.Node)
+	collect = func(n *html.Node) {
+		if n.Type == html.TextNode {
+			b.WriteString(n.Data)
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			collect(c)
+		}
+	}
+	collect(node)
+	return b.String()
+}
+
+// Appends all names that are not already part of names.
+func mergeNames(names, additional []tags.UniLingual) []tags.UniLingual {
+	for _, name := range additional {
+		if !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// Appends each person of additional whose name does not match the name of a person in persons.
+func mergePersons(persons, additional []*tags.Person) []*tags.Person {
+	for _, person := range additional {
+		if !slices.ContainsFunc(persons, func(p *tags.Person) bool { return p.Name == person.Name }) {
+			persons = append(persons, person)
+		}
+	}
+	return persons
+}
@@ -0,0 +1,104 @@
+//This file is part of imdb2mkvtags ©2026 Jörg Walter
+
+package imdb
+
+import (
+	"errors"
+	"fmt"
+	"github.com/biter777/countries"
+	"github.com/jwdev42/imdb2mkvtags/internal/date"
+	"github.com/jwdev42/imdb2mkvtags/internal/global"
+	"github.com/jwdev42/imdb2mkvtags/internal/tags"
+	"github.com/jwdev42/rottensoup"
+	"golang.org/x/net/html"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var matchLocationLink = regexp.MustCompile("locations=")
+var regexpDateRangeDelim = regexp.MustCompile("\\s+[-–—]\\s+")
+
+// represents "locations" pages https://www.imdb.com/title/$titleID/locations
+type Locations struct {
+	root *html.Node
+}
+
+func NewLocations(r io.Reader) (*Locations, error) {
+	root, err := html.Parse(r)
+	if err != nil {
+		return nil, err
+	}
+	return &Locations{
+		root: root,
+	}, nil
+}
+
+// Scrapes the filming locations in the order of the page. Each location is taken from
+// the text of its search link which has the form "/search/title/?locations=..." and converted
+// into the form of matroska's RECORDING_LOCATION. Locations without a known country are skipped.
+func (r *Locations) FilmingLocations() ([]tags.UniLingual, error) {
+	section := rottensoup.FirstElementByAttr(r.root, html.Attribute{Key: attrTestID, Val: "sub-section-flmg_locations"})
+	if section == nil {
+		return nil, errors.New("No filming locations section found")
+	}
+	links := rottensoup.ElementsByAttrMatch(section, "", "href", matchLocationLink)
+	locations := make([]tags.UniLingual, 0, len(links))
+	for _, link := range links {
+		location, err := recordingLocation(nodeText(link))
+		if err != nil {
+			global.Log.Info(fmt.Errorf("Filming locations: %s", err))
+			continue
+		}
+		if !slices.Contains(locations, location) {
+			locations = append(locations, location)
+		}
+	}
+	if len(locations) < 1 {
+		return nil, errors.New("No filming locations found")
+	}
+	return locations, nil
+}
+
+// Converts a filming location like "Knebworth House, Knebworth, Hertfordshire, England, UK" into the form
+// of matroska's RECORDING_LOCATION: The country's Alpha-2 code followed by the other parts of the location
+// from the largest to the smallest, e.g. "GB, England, Hertfordshire, Knebworth, Knebworth House".
+func recordingLocation(location string) (tags.UniLingual, error) {
+	parts := strings.Split(location, ",")
+	for i := range parts {
+		parts[i] = strings.TrimSpace(parts[i])
+	}
+	parts = slices.DeleteFunc(parts, func(part string) bool { return part == "" })
+	if len(parts) < 1 {
+		return "", errors.New("Empty location")
+	}
+	cc := countries.ByName(parts[len(parts)-1])
+	if !cc.IsValid() {
+		return "", fmt.Errorf("Location %q does not end with a known country", strings.TrimSpace(location))
+	}
+	parts[len(parts)-1] = cc.Alpha2()
+	slices.Reverse(parts)
+	return tags.UniLingual(strings.Join(parts, ", ")), nil
+}
+
+// Scrapes the start of the filming dates, e.g. "Dec 13, 1982 - Mar 25, 1983", as ISO 8601 date.
+func (r *Locations) DateRecorded() (tags.UniLingual, error) {
+	section := rottensoup.FirstElementByAttr(r.root, html.Attribute{Key: attrTestID, Val: "sub-section-flmg_dates"})
+	if section == nil {
+		return "", errors.New("No filming dates section found")
+	}
+	for _, item := range outerListItems(section) {
+		text := strings.TrimSpace(nodeText(item))
+		if text == "" {
+			continue
+		}
+		start := regexpDateRangeDelim.Split(text, 2)[0]
+		iso, err := date.ISO8601(start)
+		if err != nil {
+			return "", fmt.Errorf("Filming dates: %s", err)
+		}
+		return tags.UniLingual(iso), nil
+	}
+	return "", errors.New("No filming dates found")
+}
@@ -0,0 +1,87 @@
+//This file is part of imdb2mkvtags ©2026 Jörg Walter
+
+package imdb
+
+import (
+	"github.com/jwdev42/imdb2mkvtags/internal/tags"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestFilmingLocations(t *testing.T) {
+	locations, err := NewLocations(openFixture(t, "locations.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []tags.UniLingual{
+		"GB, England, Buckinghamshire, Iver Heath, Pinewood Studios",
+		"GB, England, Hertfordshire, Knebworth, Knebworth House",
+	}
+	res, err := locations.FilmingLocations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(res, expected) {
+		t.Errorf("FilmingLocations: Expected %q, got %q", expected, res)
+	}
+}
+
+func TestRecordingLocation(t *testing.T) {
+	tests := map[string]tags.UniLingual{
+		"Knebworth House, Knebworth, Hertfordshire, England, UK": "GB, England, Hertfordshire, Knebworth, Knebworth House",
+		"Monument Valley, Utah, USA":                             "US, Utah, Monument Valley",
+		" Berlin , Deutschland ":                                 "DE, Berlin",
+		"France":                                                 "FR",
+	}
+	for text, expected := range tests {
+		if res, err := recordingLocation(text); err != nil {
+			t.Errorf("recordingLocation(%q): %s", text, err)
+		} else if res != expected {
+			t.Errorf("recordingLocation(%q): Expected %q, got %q", text, expected, res)
+		}
+	}
+	for _, text := range []string{"", " , ", "Studio 7, Atlantis"} {
+		if res, err := recordingLocation(text); err == nil {
+			t.Errorf("recordingLocation(%q): Expected error, got %q", text, res)
+		}
+	}
+}
+
+func TestDateRecorded(t *testing.T) {
+	locations, err := NewLocations(openFixture(t, "locations.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res, err := locations.DateRecorded(); err != nil {
+		t.Error(err)
+	} else if res != "1988-10-10" {
+		t.Errorf("DateRecorded: Expected \"1988-10-10\", got %q", res)
+	}
+	tests := map[string]tags.UniLingual{
+		"Dec 13, 1982 - Mar 25, 1983": "1982-12-13",
+		"March 1983 – June 1983":      "1983-03",
+		"1983":                        "1983",
+		"13 December 1982":            "1982-12-13",
+	}
+	for text, expected := range tests {
+		locations, err := NewLocations(strings.NewReader(`<div data-testid="sub-section-flmg_dates"><ul><li></li><li>` + text + `</li></ul></div>`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res, err := locations.DateRecorded(); err != nil {
+			t.Errorf("DateRecorded(%q): %s", text, err)
+		} else if res != expected {
+			t.Errorf("DateRecorded(%q): Expected %q, got %q", text, expected, res)
+		}
+	}
+	for _, text := range []string{"", "TBA - 1983"} {
+		locations, err := NewLocations(strings.NewReader(`<div data-testid="sub-section-flmg_dates"><ul><li>` + text + `</li></ul></div>`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res, err := locations.DateRecorded(); err == nil {
+			t.Errorf("DateRecorded(%q): Expected error, got %q", text, res)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+//This file is part of imdb2mkvtags ©2026 Jörg Walter
+
+package imdb
+
+import (
+	"errors"
+	"fmt"
+	"github.com/biter777/countries"
+	"github.com/jwdev42/imdb2mkvtags/internal/global"
+	"github.com/jwdev42/imdb2mkvtags/internal/tags"
+	"github.com/jwdev42/rottensoup"
+	"golang.org/x/net/html"
+	"io"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var matchCertificateLink = regexp.MustCompile("certificates=")
+
+// Maps the fields of tags.ParentalGuide to the anchor names of their sections on the parental guide page.
+var advisoryAnchors = map[string]string{
+	"Alcohol":     "alcohol",
+	"Frightening": "frightening",
+	"Nudity":      "nudity",
+	"Profanity":   "profanity",
+	"Violence":    "violence",
+}
+
+// A certificate (law rating) issued by a country's rating board.
+type Certificate struct {
+	Country countries.CountryCode
+	Rating  string
+}
+
+// represents "parentalguide" pages https://www.imdb.com/title/$titleID/parentalguide
+type ParentalGuide struct {
+	root *html.Node
+}
+
+func NewParentalGuide(r io.Reader) (*ParentalGuide, error) {
+	root, err := html.Parse(r)
+	if err != nil {
+		return nil, err
+	}
+	return &ParentalGuide{
+		root: root,
+	}, nil
+}
+
+// Scrapes all certificates from the certificates section. The country of a certificate is taken
+// from its search link which has the form "/search/title/?certificates=US:R".
+func (r *ParentalGuide) Certificates() ([]Certificate, error) {
+	links := rottensoup.ElementsByAttrMatch(r.root, "", "href", matchCertificateLink)
+	if links == nil {
+		return nil, errors.New("No certificates found")
+	}
+	certs := make([]Certificate, 0, len(links))
+	for _, link := range links {
+		href, err := url.Parse(rottensoup.AttrVal(link, "", "href"))
+		if err != nil {
+			global.Log.Infof("Skipping certificate with malformed link: %s", err)
+			continue
+		}
+		code, rating, ok := strings.Cut(href.Query().Get("certificates"), ":")
+		if !ok {
+			global.Log.Infof("Skipping certificate link without country code: %s", href)
+			continue
+		}
+		cc := countries.ByName(code)
+		if !cc.IsValid() {
+			global.Log.Infof("Skipping certificate for unknown country %q", code)
+			continue
+		}
+		// Prefer the displayed text as it is not mangled by url encoding
+		if text := rottensoup.FirstNodeByType(link, html.TextNode); text != nil && strings.TrimSpace(text.Data) != "" {
+			rating = strings.TrimSpace(text.Data)
+		}
+		certs = append(certs, Certificate{Country: cc, Rating: rating})
+	}
+	if len(certs) < 1 {
+		return nil, errors.New("No applicable certificates found")
+	}
+	return certs, nil
+}
+
+// Scrapes the severity rating of each content advisory section.
+// Sections missing on the page are skipped as not every title is rated in every category.
+func (r *ParentalGuide) Advisories() (*tags.ParentalGuide, error) {
+	guide := new(tags.ParentalGuide)
+	for field, anchor := range advisoryAnchors {
+		if rottensoup.ElementByID(r.root, anchor) == nil {
+			global.Log.Infof("Skipping content advisory %q as its section is missing", anchor)
+			continue
+		}
+		guide.SetFieldCallback(field, func() (tags.UniLingual, error) {
+			return r.severity(anchor)
+		})
+	}
+	if guide.IsEmpty() {
+		return nil, errors.New("No content advisories found")
+	}
+	return guide, nil
+}
+
+// Scrapes the severity rating of the content advisory section with the given anchor name.
+func (r *ParentalGuide) severity(anchor string) (tags.UniLingual, error) {
+	section := sectionByAnchor(r.root, anchor)
+	if section == nil {
+		return "", fmt.Errorf("No content advisory section %q found", anchor)
+	}
+	signpost := rottensoup.FirstElementByClassName(section, "ipc-signpost__text")
+	if signpost == nil {
+		return "", fmt.Errorf("No severity found for content advisory %q", anchor)
+	}
+	text := strings.TrimSpace(nodeText(signpost))
+	if text == "" {
+		return "", fmt.Errorf("Empty severity found for content advisory %q", anchor)
+	}
+	return tags.UniLingual(text), nil
+}
@@ -0,0 +1,55 @@
+//This file is part of imdb2mkvtags ©2026 Jörg Walter
+
+package imdb
+
+import (
+	"github.com/biter777/countries"
+	"github.com/jwdev42/imdb2mkvtags/internal/tags"
+	"slices"
+	"testing"
+)
+
+func TestCertificates(t *testing.T) {
+	guide, err := NewParentalGuide(openFixture(t, "parentalguide.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []Certificate{
+		{Country: countries.USA, Rating: "PG-13"},
+		{Country: countries.Germany, Rating: "12"},
+		{Country: countries.Germany, Rating: "16"},
+		{Country: countries.India, Rating: "U/A 13+"},
+	}
+	certs, err := guide.Certificates()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(certs, expected) {
+		t.Errorf("Certificates: Expected %v, got %v", expected, certs)
+	}
+}
+
+func TestAdvisories(t *testing.T) {
+	guide, err := NewParentalGuide(openFixture(t, "parentalguide.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	advisories, err := guide.Advisories()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := map[string]struct {
+		res, expected tags.UniLingual
+	}{
+		"Alcohol":     {advisories.Alcohol, ""},
+		"Frightening": {advisories.Frightening, ""},
+		"Nudity":      {advisories.Nudity, "Mild"},
+		"Profanity":   {advisories.Profanity, "Mild"},
+		"Violence":    {advisories.Violence, "Moderate"},
+	}
+	for field, test := range tests {
+		if test.res != test.expected {
+			t.Errorf("Advisories: Expected %s %q, got %q", field, test.expected, test.res)
+		}
+	}
+}
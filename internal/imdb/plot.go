@@ -0,0 +1,136 @@
+//This file is part of imdb2mkvtags ©2026 Jörg Walter
+
+package imdb
+
+import (
+	"errors"
+	"fmt"
+	"github.com/jwdev42/imdb2mkvtags/internal/lcconv"
+	"github.com/jwdev42/imdb2mkvtags/internal/tags"
+	"github.com/jwdev42/rottensoup"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+	"io"
+	"regexp"
+	"strings"
+)
+
+const classHtmlContent = "ipc-html-content-inner-div"
+
+var matchAuthorLink = regexp.MustCompile("plot_author=|/user/ur\\d+")
+
+// represents pages that consist of lists of texts like the "taglines" page https://www.imdb.com/title/$titleID/taglines
+// or the "plotsummary" page https://www.imdb.com/title/$titleID/plotsummary
+type Plot struct {
+	root *html.Node
+	lang string // Language subtag of the document's language
+}
+
+// Parses the document from r. If the document does not state its language, fallbackLang is assumed.
+func NewPlot(r io.Reader, fallbackLang string) (*Plot, error) {
+	root, err := html.Parse(r)
+	if err != nil {
+		return nil, err
+	}
+	lang := documentLang(root)
+	if lang == "" {
+		lang = fallbackLang
+	}
+	return &Plot{
+		root: root,
+		lang: lang,
+	}, nil
+}
+
+// Scrapes all taglines from a taglines page.
+func (r *Plot) Taglines() ([]tags.MultiLingual, error) {
+	return r.texts(r.root, false)
+}
+
+// Scrapes all summaries from a plot summary page, the first one being the outline.
+func (r *Plot) Summaries() ([]tags.MultiLingual, error) {
+	return r.subSectionTexts("sub-section-summaries", true)
+}
+
+// Scrapes the synopsis from a plot summary page. The synopsis usually contains spoilers.
+func (r *Plot) Synopses() ([]tags.MultiLingual, error) {
+	return r.subSectionTexts("sub-section-synopsis", false)
+}
+
+func (r *Plot) subSectionTexts(testID string, authored bool) ([]tags.MultiLingual, error) {
+	section := rottensoup.FirstElementByAttr(r.root, html.Attribute{Key: attrTestID, Val: testID})
+	if section == nil {
+		return nil, fmt.Errorf("No subsection %q found", testID)
+	}
+	return r.texts(section, authored)
+}
+
+// Returns the texts of all html content elements below node.
+// If authored is true, the texts end with an author line that is stripped.
+func (r *Plot) texts(node *html.Node, authored bool) ([]tags.MultiLingual, error) {
+	texts := make([]tags.MultiLingual, 0, 5)
+	for _, content := range rottensoup.ElementsByClassName(node, classHtmlContent) {
+		var text string
+		if authored {
+			text = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(paragraphText(content, isAuthor)), "—"))
+		} else {
+			text = strings.TrimSpace(paragraphText(content, nil))
+		}
+		if text != "" {
+			texts = append(texts, tags.MultiLingual{Text: text, Lang: r.lang})
+		}
+	}
+	if len(texts) < 1 {
+		return nil, errors.New("No texts found")
+	}
+	return texts, nil
+}
+
+// Returns the language subtag of the language stated by the document's html element, e.g. "de" for lang="de-DE".
+// Returns an empty string if no valid language is stated.
+func documentLang(root *html.Node) string {
+	element := rottensoup.FirstElementByTag(root, atom.Html)
+	if element == nil {
+		return ""
+	}
+	lang, err := lcconv.NewLngCntry(rottensoup.AttrVal(element, "", "lang"))
+	if err != nil {
+		return ""
+	}
+	return lang.Language()
+}
+
+// Reports whether node holds the author of a summary, e.g. <span>—<a href="/user/ur0000001/">Author</a></span>.
+func isAuthor(node *html.Node) bool {
+	if node.Type != html.ElementNode {
+		return false
+	}
+	if node.DataAtom == atom.A {
+		return matchAuthorLink.MatchString(rottensoup.AttrVal(node, "", "href"))
+	}
+	return strings.HasPrefix(strings.TrimSpace(nodeText(node)), "—") &&
+		len(rottensoup.ElementsByAttrMatch(node, "", "href", matchAuthorLink)) > 0
+}
+
+// Returns the concatenated text of all text nodes below node. Line breaks are preserved.
+// Nodes for which skip returns true are left out, skip may be nil.
+func paragraphText(node *html.Node, skip func(*html.Node) bool) string {
+	var b strings.Builder
+	var collect func(*html.Node)
+	collect = func(n *html.Node) {
+		if skip != nil && skip(n) {
+			return
+		}
+		switch {
+		case n.Type == html.TextNode:
+			b.WriteString(n.Data)
+		case n.Type == html.ElementNode && n.DataAtom == atom.Br:
+			b.WriteString("\n")
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			collect(c)
+		}
+	}
+	collect(node)
+	return b.String()
+}
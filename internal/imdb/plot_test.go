@@ -0,0 +1,70 @@
+//This file is part of imdb2mkvtags ©2026 Jörg Walter
+
+package imdb
+
+import (
+	"github.com/jwdev42/imdb2mkvtags/internal/tags"
+	"golang.org/x/net/html"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestPlot(t *testing.T) {
+	taglines, err := NewPlot(openFixture(t, "taglines.html"), "en")
+	if err != nil {
+		t.Fatal(err)
+	}
+	summaries, err := NewPlot(openFixture(t, "plotsummary.html"), "en")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := map[string]struct {
+		scrape   func() ([]tags.MultiLingual, error)
+		expected []tags.MultiLingual
+	}{
+		"Taglines": {taglines.Taglines, []tags.MultiLingual{
+			{Text: "Have you ever danced with the devil in the pale moonlight?", Lang: "en"},
+			{Text: "Tonight, the night belongs to him — The Dark Knight", Lang: "en"},
+		}},
+		"Summaries": {summaries.Summaries, []tags.MultiLingual{
+			{Text: "Der Dunkle Ritter von Gotham City beginnt seinen Krieg gegen das Verbrechen.", Lang: "de"},
+			{Text: "Gotham City versinkt im Verbrechen.\nNur einer stellt sich dagegen.", Lang: "de"},
+			{Text: "Der Joker – ein Gangster – übernimmt die Unterwelt.", Lang: "de"},
+		}},
+		"Synopses": {summaries.Synopses, []tags.MultiLingual{
+			{Text: "Als Kind musste Bruce Wayne den Mord an seinen Eltern mit ansehen.\n\nJahre später — inzwischen Milliardär — wacht er als Batman über die Stadt.", Lang: "de"},
+		}},
+	}
+	for name, test := range tests {
+		res, err := test.scrape()
+		if err != nil {
+			t.Errorf("%s: %s", name, err)
+		} else if !slices.Equal(res, test.expected) {
+			t.Errorf("%s: Expected %q, got %q", name, test.expected, res)
+		}
+	}
+	if res, err := taglines.Summaries(); err == nil {
+		t.Errorf("Summaries: Expected error for taglines page, got %q", res)
+	}
+}
+
+func TestDocumentLang(t *testing.T) {
+	tests := map[string]string{
+		`<html lang="de-DE">`:      "de",
+		`<html lang="zh-Hant-TW">`: "zh",
+		`<html lang="pt">`:         "pt",
+		`<html lang="iw-IL">`:      "he",
+		`<html lang="xx-XX">`:      "",
+		`<html>`:                   "",
+	}
+	for doc, expected := range tests {
+		root, err := html.Parse(strings.NewReader(doc))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res := documentLang(root); res != expected {
+			t.Errorf("documentLang(%q): Expected %q, got %q", doc, expected, res)
+		}
+	}
+}
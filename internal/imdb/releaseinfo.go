@@ -0,0 +1,200 @@
+//This file is part of imdb2mkvtags ©2026 Jörg Walter
+
+package imdb
+
+import (
+	"errors"
+	"fmt"
+	"github.com/biter777/countries"
+	"github.com/emvi/iso-639-1"
+	"github.com/jwdev42/imdb2mkvtags/internal/date"
+	"github.com/jwdev42/imdb2mkvtags/internal/global"
+	"github.com/jwdev42/imdb2mkvtags/internal/lcconv"
+	"github.com/jwdev42/rottensoup"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+	"io"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var matchRegionLink = regexp.MustCompile("region=")
+
+const (
+	classListItemLabel   = "ipc-metadata-list-item__label"
+	classListItemContent = "ipc-metadata-list-item__list-content-item"
+	classListItemSubText = "ipc-metadata-list-item__list-content-item--subText"
+)
+
+// A release of the movie in a country.
+type Release struct {
+	Country countries.CountryCode
+	Date    string // ISO 8601 date
+	Note    string // Annotation like "premiere" or "limited"
+}
+
+// An alternative title ("also known as") of the movie.
+type AKA struct {
+	Title   string
+	Country countries.CountryCode // Invalid if the title is not bound to a country
+	Lang    string                // ISO-639-1 code, empty if unknown
+	Note    string                // Annotation like "German title" or "working title"
+}
+
+// represents "releaseinfo" pages https://www.imdb.com/title/$titleID/releaseinfo
+type ReleaseInfo struct {
+	root *html.Node
+}
+
+func NewReleaseInfo(r io.Reader) (*ReleaseInfo, error) {
+	root, err := html.Parse(r)
+	if err != nil {
+		return nil, err
+	}
+	return &ReleaseInfo{
+		root: root,
+	}, nil
+}
+
+// Scrapes the release dates. The country of a release is taken from its calendar link
+// which has the form "/calendar/?region=US".
+func (r *ReleaseInfo) Releases() ([]Release, error) {
+	items, err := r.listItems("sub-section-releases")
+	if err != nil {
+		return nil, err
+	}
+	releases := make([]Release, 0, len(items))
+	for i, item := range items {
+		links := rottensoup.ElementsByAttrMatch(item, "", "href", matchRegionLink)
+		if links == nil {
+			global.Log.Infof("Release %d: No region link found", i+1)
+			continue
+		}
+		href, err := url.Parse(rottensoup.AttrVal(links[0], "", "href"))
+		if err != nil {
+			global.Log.Infof("Release %d: Malformed region link: %s", i+1, err)
+			continue
+		}
+		cc := countries.ByName(href.Query().Get("region"))
+		if !cc.IsValid() {
+			global.Log.Infof("Release %d: Unknown region %q", i+1, href.Query().Get("region"))
+			continue
+		}
+		entries := listItemEntries(item)
+		if len(entries) < 1 {
+			global.Log.Infof("Release %d: No release date found", i+1)
+			continue
+		}
+		iso, err := date.ISO8601(entries[0].Value)
+		if err != nil {
+			global.Log.Infof("Release %d: %s", i+1, err)
+			continue
+		}
+		releases = append(releases, Release{Country: cc, Date: iso, Note: entries[0].Note})
+	}
+	if len(releases) < 1 {
+		return nil, errors.New("No applicable release dates found")
+	}
+	return releases, nil
+}
+
+// Scrapes the alternative titles. The language of a title is taken from its annotation
+// if it names a language, e.g. "German title". Otherwise the predominant language of the title's
+// country is assumed.
+func (r *ReleaseInfo) AKAs() ([]AKA, error) {
+	items, err := r.listItems("sub-section-akas")
+	if err != nil {
+		return nil, err
+	}
+	akas := make([]AKA, 0, len(items))
+	for i, item := range items {
+		entries := listItemEntries(item)
+		if len(entries) < 1 {
+			global.Log.Infof("AKA %d: No title found", i+1)
+			continue
+		}
+		aka := AKA{Title: entries[0].Value, Note: entries[0].Note}
+		if label := rottensoup.FirstElementByClassName(item, classListItemLabel); label != nil {
+			aka.Country = countries.ByName(strings.TrimSpace(nodeText(label)))
+		}
+		aka.Lang = languageFromNote(aka.Note)
+		if aka.Lang == "" && aka.Country.IsValid() {
+			aka.Lang = lcconv.CountryLanguage(aka.Country.Alpha2())
+		}
+		akas = append(akas, aka)
+	}
+	if len(akas) < 1 {
+		return nil, errors.New("No applicable alternative titles found")
+	}
+	return akas, nil
+}
+
+// Returns the list items of the subsection with the given test ID.
+func (r *ReleaseInfo) listItems(testID string) ([]*html.Node, error) {
+	section := rottensoup.FirstElementByAttr(r.root, html.Attribute{Key: attrTestID, Val: testID})
+	if section == nil {
+		return nil, fmt.Errorf("No subsection %q found", testID)
+	}
+	items := outerListItems(section)
+	if len(items) < 1 {
+		return nil, fmt.Errorf("Subsection %q contains no list items", testID)
+	}
+	return items, nil
+}
+
+// Returns all list items below root that are not nested in another list item below root.
+func outerListItems(root *html.Node) []*html.Node {
+	items := make([]*html.Node, 0, 10)
+	for _, item := range rottensoup.ElementsByTag(root, atom.Li) {
+		nested := false
+		for n := item.Parent; n != nil && n != root; n = n.Parent {
+			if n.Type == html.ElementNode && n.DataAtom == atom.Li {
+				nested = true
+				break
+			}
+		}
+		if !nested {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// A value of a metadata list item with its optional annotation.
+type listEntry struct {
+	Value string
+	Note  string // Annotation without its enclosing parentheses
+}
+
+// Returns the values of a metadata list item's content elements. An annotation is
+// assigned to the value that precedes it.
+func listItemEntries(item *html.Node) []listEntry {
+	entries := make([]listEntry, 0, 1)
+	for _, content := range rottensoup.ElementsByClassName(item, classListItemContent) {
+		text := strings.TrimSpace(nodeText(content))
+		if text == "" {
+			continue
+		}
+		if slices.Contains(strings.Fields(rottensoup.AttrVal(content, "", "class")), classListItemSubText) {
+			if len(entries) > 0 && entries[len(entries)-1].Note == "" {
+				entries[len(entries)-1].Note = strings.TrimSuffix(strings.TrimPrefix(text, "("), ")")
+			}
+		} else {
+			entries = append(entries, listEntry{Value: text})
+		}
+	}
+	return entries
+}
+
+// Returns the ISO-639-1 code of the first english language name in note.
+// Returns an empty string if note does not name a language.
+func languageFromNote(note string) string {
+	for _, word := range strings.Fields(note) {
+		if code := iso6391.CodeForName(word); code != "" {
+			return code
+		}
+	}
+	return ""
+}
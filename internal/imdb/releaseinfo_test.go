@@ -0,0 +1,67 @@
+//This file is part of imdb2mkvtags ©2026 Jörg Walter
+
+package imdb
+
+import (
+	"github.com/biter777/countries"
+	"github.com/jwdev42/imdb2mkvtags/internal/tags"
+	"slices"
+	"testing"
+)
+
+func TestReleases(t *testing.T) {
+	info, err := NewReleaseInfo(openFixture(t, "releaseinfo.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []Release{
+		{Country: countries.USA, Date: "1989-06-19", Note: "Westwood, California, premiere"},
+		{Country: countries.USA, Date: "1989-06-23"},
+		{Country: countries.France, Date: "1989-09-13", Note: "limited"},
+	}
+	releases, err := info.Releases()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(releases, expected) {
+		t.Errorf("Releases: Expected %v, got %v", expected, releases)
+	}
+}
+
+func TestAKAs(t *testing.T) {
+	info, err := NewReleaseInfo(openFixture(t, "releaseinfo.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []AKA{
+		{Title: "Batman", Country: countries.Unknown},
+		{Title: "Batman le film", Country: countries.Canada, Lang: "fr", Note: "French title"},
+		{Title: "バットマン", Country: countries.Japan, Lang: "ja"},
+		{Title: "The Batman", Country: countries.Unknown, Lang: "en", Note: "English title"},
+	}
+	akas, err := info.AKAs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(akas, expected) {
+		t.Errorf("AKAs: Expected %v, got %v", expected, akas)
+	}
+}
+
+func TestMergeAKAs(t *testing.T) {
+	titles := []tags.MultiLingual{{Text: "Batman", Lang: "de"}, {Text: "Batman le film", Lang: "en"}}
+	akas := []AKA{
+		{Title: "Batman", Country: countries.Unknown, Note: "original title"},
+		{Title: "Batman le film", Country: countries.Canada, Lang: "fr", Note: "French title"},
+		{Title: "バットマン", Country: countries.Japan, Lang: "ja"},
+		{Title: "The Bat", Country: countries.Unknown, Note: "working title"},
+	}
+	expected := []tags.MultiLingual{
+		{Text: "Batman", Lang: "de"},
+		{Text: "Batman le film", Lang: "fr"},
+		{Text: "バットマン", Lang: "ja"},
+	}
+	if res := mergeAKAs(titles, akas); !slices.Equal(res, expected) {
+		t.Errorf("mergeAKAs: Expected %q, got %q", expected, res)
+	}
+}
@@ -3,11 +3,20 @@
 package schema
 
 import (
+	"errors"
+	"fmt"
+	"github.com/jwdev42/imdb2mkvtags/internal/date"
+	"github.com/jwdev42/imdb2mkvtags/internal/global"
 	"github.com/jwdev42/imdb2mkvtags/internal/lcconv"
 	"github.com/jwdev42/imdb2mkvtags/internal/tags"
 	"html"
+	"regexp"
+	"strconv"
 )
 
+var regexpDuration = regexp.MustCompile("^PT(?:(\\d+)H)?(?:(\\d+)M)?(?:\\d+S)?$")
+var regexpNameID = regexp.MustCompile("nm\\d+")
+
 type Thing struct {
 	AdditionalType            string `json:"additionalType"`
 	AlternateName             string `json:"alternateName"`
@@ -24,16 +33,74 @@ type Thing struct {
 	Url                       string `json:"url"`
 }
 
+// Converts a thing of type "Person" into a person tag, billing is the person's position in the credits.
+// The IMDB name ID is taken from the person's URL.
+func (r *Thing) Person(billing int) *tags.Person {
+	person := &tags.Person{
+		Name:    html.UnescapeString(r.Name),
+		Billing: tags.UniLingual(strconv.Itoa(billing)),
+	}
+	if id := regexpNameID.FindString(r.Url); id != "" {
+		person.ID = tags.UniLingual(id)
+		person.URL = tags.UniLingual("https://www.imdb.com/name/" + id + "/")
+	}
+	return person
+}
+
+type AggregateRating struct {
+	BestRating  float64 `json:"bestRating"`
+	RatingCount int     `json:"ratingCount"`
+	RatingValue float64 `json:"ratingValue"`
+	WorstRating float64 `json:"worstRating"`
+}
+
 type Movie struct {
 	Thing
-	Actors        []Thing  `json:"actor"`
-	ContentRating string   `json:"contentRating"`
-	Context       string   `json:"@context"`
-	Creators      []Thing  `json:"creator"`
-	DatePublished string   `json:"datePublished"`
-	Directors     []Thing  `json:"director"`
-	Genres        []string `json:"genre"`
-	Keywords      string   `json:"keywords"`
+	AggregateRating *AggregateRating `json:"aggregateRating"`
+	Actors          []Thing          `json:"actor"`
+	ContentRating   string           `json:"contentRating"`
+	Context         string           `json:"@context"`
+	Creators        []Thing          `json:"creator"`
+	DatePublished   string           `json:"datePublished"`
+	Directors       []Thing          `json:"director"`
+	Duration        string           `json:"duration"`
+	Genres          []string         `json:"genre"`
+	Keywords        string           `json:"keywords"`
+}
+
+// Returns the aggregate rating as IMDB rating.
+func (r *Movie) Ratings() ([]*tags.Rating, error) {
+	if r.AggregateRating == nil || r.AggregateRating.RatingValue <= 0 {
+		return nil, errors.New("No aggregate rating available")
+	}
+	best := r.AggregateRating.BestRating
+	if best <= 0 {
+		best = 10
+	}
+	return []*tags.Rating{tags.NewRating("IMDb", r.AggregateRating.RatingValue, best, r.AggregateRating.RatingCount)}, nil
+}
+
+// Returns the runtime in minutes, converted from the ISO 8601 duration, e.g. "PT1H56M".
+func (r *Movie) Runtime() (int, error) {
+	matches := regexpDuration.FindStringSubmatch(r.Duration)
+	if matches == nil || r.Duration == "PT" {
+		return 0, fmt.Errorf("Malformed duration %q", r.Duration)
+	}
+	var minutes int
+	for i, factor := range []int{60, 1} {
+		if matches[i+1] == "" {
+			continue
+		}
+		n, err := strconv.Atoi(matches[i+1])
+		if err != nil {
+			return 0, err
+		}
+		minutes += n * factor
+	}
+	if minutes < 1 {
+		return 0, fmt.Errorf("Duration %q is shorter than a minute", r.Duration)
+	}
+	return minutes, nil
 }
 
 // Converts the imdb-imported json movie schema to imdb2mkvtags' internal data type.
@@ -47,9 +114,9 @@ func (r *Movie) Convert(preferredLang, defaultLang *lcconv.LngCntry) *tags.Movie
 
 	if r.Actors != nil && len(r.Actors) > 0 {
 		actors := make([]tags.Actor, 0, len(r.Actors))
-		for _, sActor := range r.Actors {
+		for i, sActor := range r.Actors {
 			if len(sActor.Name) > 0 {
-				actors = append(actors, tags.Actor{Name: html.UnescapeString(sActor.Name)})
+				actors = append(actors, tags.Actor{Person: *sActor.Person(i + 1)})
 			}
 		}
 		if len(actors) > 0 {
@@ -58,23 +125,25 @@ func (r *Movie) Convert(preferredLang, defaultLang *lcconv.LngCntry) *tags.Movie
 	}
 
 	if len(r.DatePublished) > 0 {
-		movie.DateReleased = tags.UniLingual(html.UnescapeString(r.DatePublished))
+		if iso, err := date.ISO8601(html.UnescapeString(r.DatePublished)); err == nil {
+			movie.DateReleased = tags.UniLingual(iso)
+		}
 	}
 
 	if len(r.Description) > 0 {
 		movie.Synopses = []tags.MultiLingual{
 			{
 				Text: html.UnescapeString(r.Description),
-				Lang: preferredLang.ISO6391(),
+				Lang: preferredLang.Language(),
 			},
 		}
 	}
 
 	if r.Directors != nil && len(r.Directors) > 0 {
-		directors := make([]tags.UniLingual, 0, len(r.Directors))
-		for _, sDirector := range r.Directors {
+		directors := make([]*tags.Person, 0, len(r.Directors))
+		for i, sDirector := range r.Directors {
 			if len(sDirector.Name) > 0 {
-				directors = append(directors, tags.UniLingual(html.UnescapeString(sDirector.Name)))
+				directors = append(directors, sDirector.Person(i+1))
 			}
 		}
 		if len(directors) > 0 {
@@ -84,31 +153,37 @@ func (r *Movie) Convert(preferredLang, defaultLang *lcconv.LngCntry) *tags.Movie
 	if r.Genres != nil && len(r.Genres) > 0 {
 		genres := make([]tags.MultiLingual, 0, len(r.Genres))
 		for _, sGenre := range r.Genres {
-			genres = append(genres, tags.MultiLingual{Text: html.UnescapeString(sGenre), Lang: preferredLang.ISO6391()})
+			genres = append(genres, tags.MultiLingual{Text: html.UnescapeString(sGenre), Lang: defaultLang.Language()})
 		}
 		movie.Genres = genres
 	}
 
 	if len(r.Keywords) > 0 {
-		movie.Keywords = []tags.MultiLingual{{Text: r.Keywords, Lang: defaultLang.ISO6391()}}
+		movie.Keywords = []tags.MultiLingual{{Text: r.Keywords, Lang: defaultLang.Language()}}
 	}
 
 	if len(r.Name) > 0 {
 		movie.Titles = []tags.MultiLingual{
 			{
 				Text: html.UnescapeString(r.Name),
-				Lang: defaultLang.ISO6391(),
+				Lang: defaultLang.Language(),
 			},
 		}
+		//The alternate name holds the original title, but json-ld does not state the original language.
+		//ORIGINAL is omitted as its title would lack a language.
 	}
 
-	country := &tags.Country{Name: preferredLang.Alpha3()}
-	lawRating := func() (tags.UniLingual, error) {
-		return tags.UniLingual(html.UnescapeString(r.ContentRating)), nil
-	}
-	country.SetFieldCallback("LawRating", lawRating)
-	if !country.IsEmpty() {
-		movie.Countries = []*tags.Country{country}
+	if preferredLang.Alpha3() == "" {
+		global.Log.Warningf("Preferred language %s has no country, the content rating is omitted", preferredLang)
+	} else {
+		country := &tags.Country{Name: preferredLang.Alpha3()}
+		lawRating := func() (tags.UniLingual, error) {
+			return tags.UniLingual(html.UnescapeString(r.ContentRating)), nil
+		}
+		country.SetFieldCallback("LawRating", lawRating)
+		if !country.IsEmpty() {
+			movie.Countries = []*tags.Country{country}
+		}
 	}
 
 	return movie
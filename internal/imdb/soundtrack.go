@@ -0,0 +1,138 @@
+//This file is part of imdb2mkvtags ©2026 Jörg Walter
+
+package imdb
+
+import (
+	"errors"
+	"github.com/jwdev42/imdb2mkvtags/internal/global"
+	"github.com/jwdev42/imdb2mkvtags/internal/tags"
+	"github.com/jwdev42/rottensoup"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+	"io"
+	"regexp"
+	"strings"
+)
+
+var regexpNameDelim = regexp.MustCompile(",\\s*")
+
+func songComposers(song *tags.Song) *[]tags.UniLingual  { return &song.Composers }
+func songPerformers(song *tags.Song) *[]tags.UniLingual { return &song.Performers }
+func songWriters(song *tags.Song) *[]tags.UniLingual    { return &song.Writers }
+
+// Maps the credit line prefixes of the soundtrack page to the fields of tags.Song.
+var songCreditPrefixes = []struct {
+	prefix string
+	field  func(*tags.Song) *[]tags.UniLingual
+}{
+	{"written by", songWriters},
+	{"lyrics by", songWriters},
+	{"music by", songComposers},
+	{"composed by", songComposers},
+	{"performed by", songPerformers},
+	{"sung by", songPerformers},
+}
+
+// A line of a soundtrack entry's credits and the names linked in it.
+type creditLine struct {
+	text  string
+	names []string
+}
+
+// represents "soundtrack" pages https://www.imdb.com/title/$titleID/soundtrack
+type Soundtrack struct {
+	root *html.Node
+}
+
+func NewSoundtrack(r io.Reader) (*Soundtrack, error) {
+	root, err := html.Parse(r)
+	if err != nil {
+		return nil, err
+	}
+	return &Soundtrack{
+		root: root,
+	}, nil
+}
+
+// Scrapes all songs with their credits.
+func (r *Soundtrack) Songs() ([]*tags.Song, error) {
+	items := rottensoup.ElementsByAttr(r.root, html.Attribute{Key: attrTestID, Val: "list-item"})
+	songs := make([]*tags.Song, 0, len(items))
+	for i, item := range items {
+		label := rottensoup.FirstElementByClassName(item, classListItemLabel)
+		if label == nil {
+			global.Log.Infof("Soundtrack entry %d contains no title", i+1)
+			continue
+		}
+		song := &tags.Song{Title: strings.TrimSpace(nodeText(label))}
+		if song.Title == "" {
+			global.Log.Infof("Soundtrack entry %d contains an empty title", i+1)
+			continue
+		}
+		if content := rottensoup.FirstElementByClassName(item, classHtmlContent); content != nil {
+			for _, line := range creditLines(content) {
+				addSongCredit(song, line)
+			}
+		}
+		songs = append(songs, song)
+	}
+	if len(songs) < 1 {
+		return nil, errors.New("No songs found")
+	}
+	return songs, nil
+}
+
+// Adds the names of a credit line like "Performed by Simon & Garfunkel" to the song.
+// The names are taken from the line's name links. Lines without name links are split at commas only,
+// as "and" or "&" are also part of the names of acts.
+func addSongCredit(song *tags.Song, line creditLine) {
+	text := strings.TrimSpace(line.text)
+	lower := strings.ToLower(text)
+	for _, credit := range songCreditPrefixes {
+		if !strings.HasPrefix(lower, credit.prefix) {
+			continue
+		}
+		names := make([]tags.UniLingual, 0, 2)
+		if len(line.names) > 0 {
+			for _, name := range line.names {
+				names = append(names, tags.UniLingual(name))
+			}
+		} else {
+			for _, name := range regexpNameDelim.Split(text[len(credit.prefix):], -1) {
+				if name = strings.TrimSpace(name); name != "" {
+					names = append(names, tags.UniLingual(name))
+				}
+			}
+		}
+		field := credit.field(song)
+		*field = mergeNames(*field, names)
+		return
+	}
+}
+
+// Splits the credits of a soundtrack entry at line breaks.
+func creditLines(content *html.Node) []creditLine {
+	lines := []creditLine{{}}
+	var b strings.Builder
+	var collect func(*html.Node)
+	collect = func(n *html.Node) {
+		switch {
+		case n.Type == html.TextNode:
+			b.WriteString(n.Data)
+		case n.Type == html.ElementNode && n.DataAtom == atom.Br:
+			lines[len(lines)-1].text = b.String()
+			b.Reset()
+			lines = append(lines, creditLine{})
+		case n.Type == html.ElementNode && n.DataAtom == atom.A && matchNameLink.MatchString(rottensoup.AttrVal(n, "", "href")):
+			if name := strings.TrimSpace(nodeText(n)); name != "" {
+				lines[len(lines)-1].names = append(lines[len(lines)-1].names, name)
+			}
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			collect(c)
+		}
+	}
+	collect(content)
+	lines[len(lines)-1].text = b.String()
+	return lines
+}
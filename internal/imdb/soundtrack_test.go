@@ -0,0 +1,63 @@
+//This file is part of imdb2mkvtags ©2026 Jörg Walter
+
+package imdb
+
+import (
+	"github.com/jwdev42/imdb2mkvtags/internal/tags"
+	"reflect"
+	"testing"
+)
+
+func TestSongs(t *testing.T) {
+	soundtrack, err := NewSoundtrack(openFixture(t, "soundtrack.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []tags.Song{
+		{Title: "Partyman", Performers: []tags.UniLingual{"Prince"}, Writers: []tags.UniLingual{"Prince"}},
+		{Title: "Scarborough Fair", Performers: []tags.UniLingual{"Simon & Garfunkel"}},
+		{
+			Title:      "Beautiful Dreamer",
+			Composers:  []tags.UniLingual{"Stephen Foster"},
+			Performers: []tags.UniLingual{"Kim Basinger", "Jack Nicholson"},
+			Writers:    []tags.UniLingual{"Stephen Foster"},
+		},
+		{Title: "Batman Theme (Score)", Composers: []tags.UniLingual{"Danny Elfman"}},
+	}
+	songs, err := soundtrack.Songs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(songs) != len(expected) {
+		t.Fatalf("Songs: Expected %d songs, got %d", len(expected), len(songs))
+	}
+	for i, song := range songs {
+		if !reflect.DeepEqual(*song, expected[i]) {
+			t.Errorf("Songs[%d]: Expected %+v, got %+v", i, expected[i], *song)
+		}
+	}
+}
+
+func TestMergePersons(t *testing.T) {
+	credits, err := NewCredits(openFixture(t, "fullcredits.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	composers, err := credits.Crew("composer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	persons := []*tags.Person{{Name: "Prince"}, {Name: "Danny Elfman"}}
+	persons = mergePersons(persons, composers)
+	names := make([]string, len(persons))
+	for i, person := range persons {
+		names[i] = person.Name
+	}
+	if expected := []string{"Prince", "Danny Elfman"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("mergePersons: Expected %q, got %q", expected, names)
+	}
+	persons = mergePersons(nil, composers)
+	if len(persons) != 1 || persons[0].Name != "Danny Elfman" || persons[0].URL == "" {
+		t.Errorf("mergePersons: Expected credited composer Danny Elfman, got %+v", persons)
+	}
+}
@@ -0,0 +1,133 @@
+//This file is part of imdb2mkvtags ©2026 Jörg Walter
+
+package imdb
+
+import (
+	"errors"
+	"fmt"
+	"github.com/jwdev42/imdb2mkvtags/internal/global"
+	"github.com/jwdev42/imdb2mkvtags/internal/tags"
+	"github.com/jwdev42/rottensoup"
+	"golang.org/x/net/html"
+	"io"
+	"regexp"
+	"strconv"
+)
+
+var regexpRuntimeTotal = regexp.MustCompile("(?i)\\((\\d+)\\s*min")
+var regexpRuntimeHours = regexp.MustCompile("(?i)(\\d+)\\s*(?:stunden|stunde|std|hours|hour|hrs|hr|heures|heure|horas|hora|ore|ora|h)\\.?(?:\\s*(\\d+)\\s*m)?")
+var regexpRuntimeMinutes = regexp.MustCompile("(?i)(\\d+)\\s*m")
+
+// Maps the fields of tags.TechSpecs that hold plain values to the test IDs of their list items.
+var techSpecTestIDs = map[string]string{
+	"AspectRatios":    "title-techspec_aspectratio",
+	"Cameras":         "title-techspec_camera",
+	"Colors":          "title-techspec_color",
+	"NegativeFormats": "title-techspec_negativeformat",
+	"SoundMixes":      "title-techspec_soundmix",
+}
+
+// represents "technical" pages https://www.imdb.com/title/$titleID/technical
+type Technical struct {
+	root *html.Node
+}
+
+func NewTechnical(r io.Reader) (*Technical, error) {
+	root, err := html.Parse(r)
+	if err != nil {
+		return nil, err
+	}
+	return &Technical{
+		root: root,
+	}, nil
+}
+
+// Scrapes all technical specifications.
+func (r *Technical) Specs() (*tags.TechSpecs, error) {
+	specs := new(tags.TechSpecs)
+	specs.SetFieldCallback("Runtimes", r.Runtimes)
+	for field, testID := range techSpecTestIDs {
+		specs.SetFieldCallback(field, func() ([]tags.UniLingual, error) {
+			return r.values(testID)
+		})
+	}
+	if specs.IsEmpty() {
+		return nil, errors.New("No technical specifications found")
+	}
+	return specs, nil
+}
+
+// Scrapes the runtime of each version. A runtime's annotation names its version, e.g. "director's cut".
+func (r *Technical) Runtimes() ([]*tags.Runtime, error) {
+	entries, err := r.entries("title-techspec_runtime")
+	if err != nil {
+		return nil, err
+	}
+	runtimes := make([]*tags.Runtime, 0, len(entries))
+	for _, entry := range entries {
+		minutes, err := parseRuntime(entry.Value)
+		if err != nil {
+			global.Log.Info(fmt.Errorf("Technical specifications: %s", err))
+			continue
+		}
+		runtimes = append(runtimes, &tags.Runtime{
+			Minutes: strconv.Itoa(minutes),
+			Version: tags.UniLingual(entry.Note),
+		})
+	}
+	if len(runtimes) < 1 {
+		return nil, errors.New("No valid runtimes found")
+	}
+	return runtimes, nil
+}
+
+// Returns the values of the list item with the given test ID, annotations are appended in parentheses.
+func (r *Technical) values(testID string) ([]tags.UniLingual, error) {
+	entries, err := r.entries(testID)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]tags.UniLingual, len(entries))
+	for i, entry := range entries {
+		if entry.Note != "" {
+			values[i] = tags.UniLingual(fmt.Sprintf("%s (%s)", entry.Value, entry.Note))
+		} else {
+			values[i] = tags.UniLingual(entry.Value)
+		}
+	}
+	return values, nil
+}
+
+func (r *Technical) entries(testID string) ([]listEntry, error) {
+	item := rottensoup.FirstElementByAttr(r.root, html.Attribute{Key: attrTestID, Val: testID})
+	if item == nil {
+		return nil, fmt.Errorf("No element found with attribute %s=\"%s\"", attrTestID, testID)
+	}
+	entries := listItemEntries(item)
+	if len(entries) < 1 {
+		return nil, fmt.Errorf("No values found in element with attribute %s=\"%s\"", attrTestID, testID)
+	}
+	return entries, nil
+}
+
+// Parses a runtime like "1h 56m(116 min)" or "1 Std. 56 Min. (116 Min.)" and returns the minutes.
+// The parenthesized total is preferred, otherwise hours and minutes are added up.
+func parseRuntime(text string) (int, error) {
+	if matches := regexpRuntimeTotal.FindStringSubmatch(text); matches != nil {
+		return strconv.Atoi(matches[1])
+	}
+	if matches := regexpRuntimeHours.FindStringSubmatch(text); matches != nil {
+		hours, _ := strconv.Atoi(matches[1])
+		minutes := hours * 60
+		if matches[2] != "" {
+			m, _ := strconv.Atoi(matches[2])
+			minutes += m
+		}
+		return minutes, nil
+	}
+	matches := regexpRuntimeMinutes.FindAllStringSubmatch(text, -1)
+	if matches == nil {
+		return 0, fmt.Errorf("Malformed runtime %q", text)
+	}
+	return strconv.Atoi(matches[len(matches)-1][1])
+}
@@ -0,0 +1,68 @@
+//This file is part of imdb2mkvtags ©2026 Jörg Walter
+
+package imdb
+
+import (
+	"github.com/jwdev42/imdb2mkvtags/internal/tags"
+	"slices"
+	"testing"
+)
+
+func TestSpecs(t *testing.T) {
+	technical, err := NewTechnical(openFixture(t, "technical.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	specs, err := technical.Specs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedRuntimes := []tags.Runtime{{Minutes: "126"}, {Minutes: "132", Version: "director's cut"}}
+	runtimes := make([]tags.Runtime, len(specs.Runtimes))
+	for i, runtime := range specs.Runtimes {
+		runtimes[i] = *runtime
+	}
+	if !slices.Equal(runtimes, expectedRuntimes) {
+		t.Errorf("Specs: Expected runtimes %v, got %v", expectedRuntimes, runtimes)
+	}
+	tests := map[string]struct {
+		res, expected []tags.UniLingual
+	}{
+		"AspectRatios":    {specs.AspectRatios, []tags.UniLingual{"1.85 : 1"}},
+		"Cameras":         {specs.Cameras, nil},
+		"Colors":          {specs.Colors, []tags.UniLingual{"Color (Technicolor)"}},
+		"NegativeFormats": {specs.NegativeFormats, nil},
+		"SoundMixes":      {specs.SoundMixes, []tags.UniLingual{"Dolby Stereo", "70 mm 6-Track (70 mm prints)"}},
+	}
+	for field, test := range tests {
+		if !slices.Equal(test.res, test.expected) {
+			t.Errorf("Specs: Expected %s %q, got %q", field, test.expected, test.res)
+		}
+	}
+}
+
+func TestParseRuntime(t *testing.T) {
+	tests := map[string]int{
+		"1h 56m(116 min)":           116,
+		"126 min":                   126,
+		"2h":                        120,
+		"45m":                       45,
+		"1h 5m":                     65,
+		"1 Std. 56 Min. (116 Min.)": 116,
+		"1 Std. 56 Min.":            116,
+		"97 Min.":                   97,
+		"1 h 56 min":                116,
+	}
+	for text, expected := range tests {
+		if res, err := parseRuntime(text); err != nil {
+			t.Errorf("parseRuntime(%q): %s", text, err)
+		} else if res != expected {
+			t.Errorf("parseRuntime(%q): Expected %d, got %d", text, expected, res)
+		}
+	}
+	for _, text := range []string{"", "varies", "TBA"} {
+		if res, err := parseRuntime(text); err == nil {
+			t.Errorf("parseRuntime(%q): Expected error, got %d", text, res)
+		}
+	}
+}
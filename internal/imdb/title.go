@@ -6,22 +6,59 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"github.com/emvi/iso-639-1"
+	"github.com/jwdev42/imdb2mkvtags/internal/date"
+	"github.com/jwdev42/imdb2mkvtags/internal/genre"
 	"github.com/jwdev42/imdb2mkvtags/internal/global"
 	"github.com/jwdev42/imdb2mkvtags/internal/imdb/schema"
+	"github.com/jwdev42/imdb2mkvtags/internal/lcconv"
 	"github.com/jwdev42/imdb2mkvtags/internal/tags"
 	"github.com/jwdev42/rottensoup"
 	"golang.org/x/net/html"
 	"golang.org/x/net/html/atom"
 	"io"
+	"math"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
 )
 
 const attrTestID = "data-testid"
 
+var matchPrimaryLanguageLink = regexp.MustCompile("primary_language=")
+var regexpAbbreviatedCount = regexp.MustCompile("^(\\d[\\d.,\\s\\x{a0}\\x{202f}]*?)\\s*(\\pL+\\.?)?$")
+
+// Maps the magnitude suffixes of abbreviated counts on localized title pages to their factors.
+var countSuffixes = map[string]float64{
+	"K":    1e3,
+	"k":    1e3,
+	"Tsd.": 1e3,
+	"mil":  1e3,
+	"M":    1e6,
+	"Mio.": 1e6,
+	"Mln":  1e6,
+	"mi":   1e6,
+	"B":    1e9,
+	"Mrd.": 1e9,
+	"Md":   1e9,
+	"bi":   1e9,
+}
+
+// Languages that use the comma as decimal separator.
+var decimalCommaLanguages = []string{"da", "de", "es", "fi", "fr", "it", "nb", "nl", "no", "pl", "pt", "ru", "sv", "tr"}
+
+// Regions that use the decimal point regardless of the language, e.g. "es-MX".
+var decimalPointRegions = []string{"MX", "US"}
+
 // represents title pages https://www.imdb.com/title/$titleID/
 type Title struct {
 	c       *Controller
 	root    *html.Node
 	credits creditsList
+	lang    *lcconv.LngCntry // Language the page was requested in, the controller's preferred language if nil
 }
 
 func NewTitle(c *Controller, r io.Reader) (*Title, error) {
@@ -35,6 +72,14 @@ func NewTitle(c *Controller, r io.Reader) (*Title, error) {
 	}, nil
 }
 
+// Returns the language of the page's localized texts.
+func (r *Title) pageLang() *lcconv.LngCntry {
+	if r.lang != nil {
+		return r.lang
+	}
+	return r.c.PreferredLang()
+}
+
 func (r *Title) parseCreditsList() error {
 	list, err := parseCreditsList(r.root)
 	if err != nil {
@@ -46,18 +91,18 @@ func (r *Title) parseCreditsList() error {
 
 // Scrapes actors and their characters from the title page.
 func (r *Title) Actors() ([]tags.Actor, error) {
-	//Closure for scraping the actor's character
-	scrapeCharacter := func(node *html.Node) (string, error) {
+	//Closure for scraping the actor's characters
+	scrapeCharacters := func(node *html.Node) ([]*tags.Character, error) {
 		const testIDCharacter = "cast-item-characters-link"
-		entry := rottensoup.FirstElementByAttr(node, html.Attribute{Key: "data-testid", Val: testIDCharacter})
-		if entry == nil {
-			return "", errors.New("No character entry available")
+		links := rottensoup.ElementsByAttr(node, html.Attribute{Key: "data-testid", Val: testIDCharacter})
+		if links == nil {
+			return nil, errors.New("No character entry available")
 		}
-		textNode := rottensoup.FirstNodeByType(entry, html.TextNode)
-		if textNode == nil {
-			return "", errors.New("Character entry contains no text")
+		characters := characters(links)
+		if len(characters) < 1 {
+			return nil, errors.New("Character entry contains no text")
 		}
-		return textNode.Data, nil
+		return characters, nil
 	}
 	const testIDActors = "title-cast-item__actor"
 	entries := rottensoup.ElementsByAttr(r.root, html.Attribute{Key: "data-testid", Val: testIDActors})
@@ -66,21 +111,21 @@ func (r *Title) Actors() ([]tags.Actor, error) {
 	}
 	actors := make([]tags.Actor, 0, len(entries))
 	for _, entry := range entries {
-		actor := tags.Actor{}
 		//Find the text node with the actor's name
 		textNode := rottensoup.FirstNodeByType(entry, html.TextNode)
 		if textNode == nil {
 			global.Log.Info("Skipping Actor entry without text node")
 			continue
 		}
-		actor.Name = textNode.Data
-		//Look up the character the actor plays
-		character, err := scrapeCharacter(entry.Parent)
+		actor := tags.Actor{Person: *newPerson(textNode.Data, entry, len(actors)+1)}
+		//Look up the characters the actor plays
+		characters, err := scrapeCharacters(entry.Parent)
 		if err != nil {
 			global.Log.Info(fmt.Errorf("Could not find the character played by %s: %s", actor.Name, err))
 		} else {
-			actor.Character = character
+			actor.Characters = characters
 		}
+		actor.Episodes = episodeCount(entry.Parent)
 		//Adding actor to the list of actors
 		actors = append(actors, actor)
 	}
@@ -98,42 +143,119 @@ func (r *Title) LawRating() (tags.UniLingual, error) {
 	return tags.UniLingual(text), nil
 }
 
+// Scrapes the IMDB rating and the Metascore. The IMDB rating is taken from the embedded json-ld data
+// as the title page only displays rounded vote counts, the rating bar is used as a fallback.
+func (r *Title) Ratings() ([]*tags.Rating, error) {
+	ratings := make([]*tags.Rating, 0, 2)
+	if rating, err := r.imdbRating(); err != nil {
+		global.Log.Info(fmt.Errorf("Could not scrape IMDB rating: %s", err))
+	} else {
+		ratings = append(ratings, rating)
+	}
+	if text, err := r.textByClassName("metacritic-score-box"); err != nil {
+		global.Log.Info(fmt.Errorf("Could not scrape Metascore: %s", err))
+	} else if score, err := strconv.Atoi(strings.TrimSpace(text)); err != nil {
+		global.Log.Info(fmt.Errorf("Malformed Metascore %q", text))
+	} else {
+		ratings = append(ratings, tags.NewRating("Metacritic", float64(score), 100, 0))
+	}
+	if len(ratings) < 1 {
+		return nil, errors.New("No ratings found")
+	}
+	return ratings, nil
+}
+
+func (r *Title) imdbRating() (*tags.Rating, error) {
+	if schema, err := movieSchema(r.root); err == nil {
+		if ratings, err := schema.Ratings(); err == nil {
+			return ratings[0], nil
+		}
+	}
+	global.Log.Debug("No json-ld aggregate rating available, using rating bar")
+	score, err := r.elementByTestID("hero-rating-bar__aggregate-rating__score")
+	if err != nil {
+		return nil, err
+	}
+	text := rottensoup.FirstNodeByType(score, html.TextNode)
+	if text == nil {
+		return nil, errors.New("Rating bar contains no score")
+	}
+	comma := decimalComma(r.pageLang())
+	value, err := parseDecimal(text.Data, comma)
+	if err != nil {
+		return nil, fmt.Errorf("Malformed rating %q", text.Data)
+	}
+	votes := 0
+	if sibling := rottensoup.NextElementSibling(score); sibling != nil {
+		if votes, err = parseAbbreviatedCount(nodeText(sibling), comma); err != nil {
+			global.Log.Info(fmt.Errorf("Could not parse vote count: %s", err))
+		}
+	}
+	return tags.NewRating("IMDb", value, 10, votes), nil
+}
+
+// Scrapes the chips of the title page's interests block that belong to IMDB's genre vocabulary.
 func (r *Title) Genres() ([]tags.MultiLingual, error) {
-	const errNoGenreData = "No genre data available"
+	genres, err := r.interestChips(true)
+	if err != nil {
+		return nil, err
+	}
+	if len(genres) < 1 {
+		return nil, errors.New("No genre data available")
+	}
+	if r.c.o.TranslateGenres {
+		// IMDB falls back to english for untranslated genres
+		genres = translateGenres(genres, r.pageLang().Language())
+	}
+	return genres, nil
+}
+
+// Scrapes the chips of the title page's interests block that are not genres, e.g. "Buddy Comedy" or "Cyberpunk".
+func (r *Title) Interests() ([]tags.MultiLingual, error) {
+	interests, err := r.interestChips(false)
+	if err != nil {
+		return nil, err
+	}
+	if len(interests) < 1 {
+		return nil, errors.New("No interests available")
+	}
+	return interests, nil
+}
+
+// Returns the texts of the chips in the interests block that are genres if genres is true,
+// or that are not genres if genres is false.
+func (r *Title) interestChips(genres bool) ([]tags.MultiLingual, error) {
 	node, err := r.elementByTestID("interests")
 	if err != nil {
 		return nil, err
 	}
 	spans := rottensoup.ElementsByTagAndAttr(node, atom.Span, html.Attribute{Key: "class", Val: "ipc-chip__text"})
 	if len(spans) < 1 {
-		return nil, errors.New(errNoGenreData)
+		return nil, errors.New("No interests block found")
 	}
-	genres := make([]tags.MultiLingual, 0, len(spans))
+	chips := make([]tags.MultiLingual, 0, len(spans))
 	for _, span := range spans {
-		if text := rottensoup.FirstNodeByType(span, html.TextNode); text != nil {
-			genres = append(genres, tags.MultiLingual{Text: text.Data, Lang: r.c.PreferredLang().ISO6391()})
+		text := rottensoup.FirstNodeByType(span, html.TextNode)
+		if text == nil || strings.TrimSpace(text.Data) == "" {
+			continue
+		}
+		if _, ok := genre.Canonical(text.Data); ok == genres {
+			chips = append(chips, tags.MultiLingual{Text: strings.TrimSpace(text.Data), Lang: r.pageLang().Language()})
 		}
 	}
-	if len(genres) < 1 {
-		return nil, errors.New(errNoGenreData)
-	}
-	return genres, nil
+	return chips, nil
 }
 
-func (r *Title) Directors() ([]tags.UniLingual, error) {
+func (r *Title) Directors() ([]*tags.Person, error) {
 	if r.credits == nil {
 		if err := r.parseCreditsList(); err != nil {
 			return nil, err
 		}
 	}
-	names := r.credits["directors"]
-	if len(names) < 1 {
+	directors := r.credits["directors"]
+	if len(directors) < 1 {
 		return nil, errors.New("No data available")
 	}
-	directors := make([]tags.UniLingual, len(names))
-	for i, name := range names {
-		directors[i] = tags.UniLingual(name)
-	}
 	return directors, nil
 }
 
@@ -142,11 +264,15 @@ func (r *Title) DateReleased() (tags.UniLingual, error) {
 	if err != nil {
 		return "", err
 	}
-	return tags.UniLingual(text), nil
+	iso, err := date.ISO8601(text)
+	if err != nil {
+		return "", err
+	}
+	return tags.UniLingual(iso), nil
 }
 
 func (r *Title) Synopsis() ([]tags.MultiLingual, error) {
-	val, err := r.testID2MultiLingual("plot-xl", r.c.PreferredLang().ISO6391())
+	val, err := r.testID2MultiLingual("plot-xl", r.pageLang().Language())
 	if err != nil {
 		return nil, err
 	}
@@ -154,27 +280,78 @@ func (r *Title) Synopsis() ([]tags.MultiLingual, error) {
 }
 
 func (r *Title) Title() ([]tags.MultiLingual, error) {
-	val, err := r.testID2MultiLingual("hero__pageTitle", r.c.PreferredLang().ISO6391())
+	val, err := r.testID2MultiLingual("hero__pageTitle", r.pageLang().Language())
 	if err != nil {
 		return nil, err
 	}
 	return []tags.MultiLingual{*val}, err
 }
 
-func (r *Title) Writers() ([]tags.UniLingual, error) {
+// Returns the original title as scraped by OriginalTitle together with the original language.
+// If title is empty, the displayed title is the original one.
+func (r *Title) Original(title string) (*tags.Original, error) {
+	if title == "" {
+		var err error
+		if title, err = r.textByTestID("hero__pageTitle"); err != nil {
+			return nil, err
+		}
+	}
+	lang, err := r.OriginalLanguage()
+	if err != nil {
+		return nil, fmt.Errorf("Could not determine the original language: %s", err)
+	}
+	return &tags.Original{Titles: []tags.MultiLingual{{Text: title, Lang: lang}}}, nil
+}
+
+// Scrapes the original title from the line below the displayed title, e.g. "Original title: Das Boot".
+func (r *Title) OriginalTitle() (string, error) {
+	heading, err := r.elementByTestID("hero__pageTitle")
+	if err != nil {
+		return "", err
+	}
+	line := rottensoup.NextElementSibling(heading)
+	if line == nil || line.DataAtom != atom.Div {
+		return "", errors.New("No original title found")
+	}
+	_, title, ok := strings.Cut(nodeText(line), ":")
+	if title = strings.TrimSpace(title); !ok || title == "" {
+		return "", errors.New("No original title found")
+	}
+	return title, nil
+}
+
+// Scrapes the ISO-639-1 code of the original language. The first language listed in the details section
+// is the original language, its search link has the form "/search/title/?primary_language=en".
+func (r *Title) OriginalLanguage() (string, error) {
+	details, err := r.elementByTestID("title-details-languages")
+	if err != nil {
+		return "", err
+	}
+	links := rottensoup.ElementsByAttrMatch(details, "", "href", matchPrimaryLanguageLink)
+	if links == nil {
+		return "", errors.New("No language link found in details section")
+	}
+	href, err := url.Parse(rottensoup.AttrVal(links[0], "", "href"))
+	if err != nil {
+		return "", err
+	}
+	code := href.Query().Get("primary_language")
+	if !iso6391.ValidCode(code) {
+		return "", fmt.Errorf("Invalid language code %q", code)
+	}
+	return code, nil
+}
+
+func (r *Title) Writers() ([]*tags.Person, error) {
 	if r.credits == nil {
 		if err := r.parseCreditsList(); err != nil {
 			return nil, err
 		}
 	}
-	names := r.credits["writers"]
-	if len(names) < 1 {
+	writers := r.credits["writers"]
+	if len(writers) < 1 {
 		return nil, errors.New("No data available")
 	}
-	writers := make([]tags.UniLingual, len(names))
-	for i, name := range names {
-		writers[i] = tags.UniLingual(name)
-	}
 	return writers, nil
 }
 
@@ -209,6 +386,18 @@ func (r *Title) textByTestID(id string) (string, error) {
 	return text.Data, nil
 }
 
+func (r *Title) textByClassName(name string) (string, error) {
+	node := rottensoup.FirstElementByClassName(r.root, name)
+	if node == nil {
+		return "", fmt.Errorf("No element found with class %q", name)
+	}
+	text := rottensoup.FirstNodeByType(node, html.TextNode)
+	if text == nil {
+		return "", fmt.Errorf("No text node found that is a child of element with class %q", name)
+	}
+	return text.Data, nil
+}
+
 func (r *Title) extractFromHeroTitleBlock(num int) (string, error) {
 	title, err := r.elementByTestID("hero__pageTitle")
 	if err != nil {
@@ -236,13 +425,65 @@ func (r *Title) extractFromHeroTitleBlock(num int) (string, error) {
 	return text.Data, nil
 }
 
+// Parses counts abbreviated with a magnitude suffix like "170K", "1.2M" or "1,2 Mio.".
+// If comma is true, the comma is the decimal separator, otherwise the point.
+func parseAbbreviatedCount(text string, comma bool) (int, error) {
+	matches := regexpAbbreviatedCount.FindStringSubmatch(strings.TrimSpace(text))
+	if matches == nil {
+		return 0, fmt.Errorf("Malformed count %q", text)
+	}
+	factor := 1.0
+	if matches[2] != "" {
+		f, ok := countSuffixes[matches[2]]
+		if !ok {
+			return 0, fmt.Errorf("Unknown magnitude suffix %q", matches[2])
+		}
+		factor = f
+	}
+	count, err := parseDecimal(matches[1], comma)
+	if err != nil {
+		return 0, fmt.Errorf("Malformed count %q", text)
+	}
+	return int(math.Round(count * factor)), nil
+}
+
+// Parses a number like "1,234.5" or "1.234,5". If comma is true, the comma is the decimal separator
+// and points are thousands separators, otherwise the other way round. Spaces are thousands separators as well.
+func parseDecimal(text string, comma bool) (float64, error) {
+	text = strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, text)
+	if comma {
+		text = strings.ReplaceAll(text, ".", "")
+		text = strings.Replace(text, ",", ".", 1)
+	} else {
+		text = strings.ReplaceAll(text, ",", "")
+	}
+	return strconv.ParseFloat(text, 64)
+}
+
+// Reports whether numbers are written with a decimal comma in the given language.
+func decimalComma(lang *lcconv.LngCntry) bool {
+	if slices.Contains(decimalPointRegions, lang.Alpha2()) {
+		return false
+	}
+	return slices.Contains(decimalCommaLanguages, lang.Language())
+}
+
 // Scrapes the json-ld data from an imdb page and loads it into a movie schema object.
 func ExtractMovieSchema(src io.Reader) (*schema.Movie, error) {
 	root, err := html.Parse(src)
 	if err != nil {
 		return nil, err
 	}
+	return movieSchema(root)
+}
 
+// Loads the json-ld data of a parsed imdb page into a movie schema object.
+func movieSchema(root *html.Node) (*schema.Movie, error) {
 	head := rottensoup.FirstElementByTag(root, atom.Head)
 	if head == nil {
 		return nil, errors.New("No html head tag found")
@@ -0,0 +1,131 @@
+//This file is part of imdb2mkvtags ©2026 Jörg Walter
+
+package imdb
+
+import (
+	"github.com/jwdev42/imdb2mkvtags/internal/lcconv"
+	"github.com/jwdev42/imdb2mkvtags/internal/tags"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestRatings(t *testing.T) {
+	c, err := NewController("imdb://tt0096895")
+	if err != nil {
+		t.Fatal(err)
+	}
+	title, err := NewTitle(c, openFixture(t, "title-ratings.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if title.lang, err = lcconv.NewLngCntry("de-DE"); err != nil {
+		t.Fatal(err)
+	}
+	expected := []tags.Rating{
+		{Normalized: "3.75", Best: "10", Source: "IMDb", Value: "7.5", Votes: "432109"},
+		{Normalized: "3.45", Best: "100", Source: "Metacritic", Value: "69"},
+	}
+	ratings, err := title.Ratings()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ratings) != len(expected) {
+		t.Fatalf("Ratings: Expected %d ratings, got %d", len(expected), len(ratings))
+	}
+	for i, rating := range ratings {
+		if *rating != expected[i] {
+			t.Errorf("Ratings[%d]: Expected %+v, got %+v", i, expected[i], *rating)
+		}
+	}
+}
+
+func TestParseAbbreviatedCount(t *testing.T) {
+	tests := []struct {
+		text     string
+		comma    bool
+		expected int
+	}{
+		{"170K", false, 170000},
+		{"1.2M", false, 1200000},
+		{"1,234", false, 1234},
+		{"987", false, 987},
+		{"1,2 Mio.", true, 1200000},
+		{"1.234", true, 1234},
+		{"170 Tsd.", true, 170000},
+		{"1,5 k", true, 1500},
+		{"432 109", true, 432109},
+		{"2,3 mil", true, 2300},
+	}
+	for _, test := range tests {
+		if res, err := parseAbbreviatedCount(test.text, test.comma); err != nil {
+			t.Errorf("parseAbbreviatedCount(%q, %t): %s", test.text, test.comma, err)
+		} else if res != test.expected {
+			t.Errorf("parseAbbreviatedCount(%q, %t): Expected %d, got %d", test.text, test.comma, test.expected, res)
+		}
+	}
+	for _, text := range []string{"", "K", "1.2X", "viele"} {
+		if res, err := parseAbbreviatedCount(text, false); err == nil {
+			t.Errorf("parseAbbreviatedCount(%q, false): Expected error, got %d", text, res)
+		}
+	}
+}
+
+func TestDecimalComma(t *testing.T) {
+	tests := map[string]bool{
+		"en-US": false,
+		"de-DE": true,
+		"de":    true,
+		"es-ES": true,
+		"es-MX": false,
+		"fr-CA": true,
+		"hi-IN": false,
+	}
+	for tag, expected := range tests {
+		lang, err := lcconv.NewLngCntry(tag)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res := decimalComma(lang); res != expected {
+			t.Errorf("decimalComma(%q): Expected %t, got %t", tag, expected, res)
+		}
+	}
+}
+
+func TestOriginal(t *testing.T) {
+	const heading = `<h1 data-testid="hero__pageTitle"><span>Das Boot</span></h1>`
+	const originalLine = `<div>Original title: Das Boot</div>`
+	const details = `<li data-testid="title-details-languages"><a href="/search/title/?primary_language=de">German</a></li>`
+	tests := []struct {
+		doc, originalTitle string
+		expected           []tags.MultiLingual
+	}{
+		{heading + originalLine + details, "Das Boot", []tags.MultiLingual{{Text: "Das Boot", Lang: "de"}}},
+		{heading + details, "", []tags.MultiLingual{{Text: "Das Boot", Lang: "de"}}},
+		{heading + originalLine, "Das Boot", nil},
+	}
+	c, err := NewController("imdb://tt0082096")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range tests {
+		title, err := NewTitle(c, strings.NewReader(test.doc))
+		if err != nil {
+			t.Fatal(err)
+		}
+		originalTitle, _ := title.OriginalTitle()
+		if originalTitle != test.originalTitle {
+			t.Errorf("OriginalTitle(%q): Expected %q, got %q", test.doc, test.originalTitle, originalTitle)
+		}
+		original, err := title.Original(originalTitle)
+		if test.expected == nil {
+			if err == nil {
+				t.Errorf("Original(%q): Expected error, got %q", test.doc, original.Titles)
+			}
+		} else if err != nil {
+			t.Errorf("Original(%q): %s", test.doc, err)
+		} else if !slices.Equal(original.Titles, test.expected) {
+			t.Errorf("Original(%q): Expected %q, got %q", test.doc, test.expected, original.Titles)
+		}
+	}
+}
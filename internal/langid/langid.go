@@ -0,0 +1,206 @@
+//This file is part of imdb2mkvtags ©2026 Jörg Walter
+
+// Package langid identifies the language of a text without network access.
+// Languages with a script of their own are identified by their script, languages written in latin script
+// by comparing the text's n-gram profile with the profiles of the embedded sample texts.
+// Texts in scripts that are shared by several languages, e.g. cyrillic, are not identified.
+package langid
+
+import (
+	"embed"
+	"errors"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+//go:embed samples/*.txt
+var samples embed.FS
+
+const profileSize = 400 // Number of n-grams a profile consists of
+const minLetters = 20   // Minimal number of letters for a reliable identification
+const minMargin = 0.02  // Minimal relative distance between the best and the second best match
+
+// Languages identified by their script. Only scripts that are used by a single language are listed,
+// Han characters are shared by chinese and japanese and therefore only count as japanese together with kana.
+var scripts = []struct {
+	table *unicode.RangeTable
+	lang  string
+}{
+	{unicode.Hiragana, "ja"},
+	{unicode.Katakana, "ja"},
+	{unicode.Hangul, "ko"},
+	{unicode.Greek, "el"},
+	{unicode.Thai, "th"},
+}
+
+const minKana = 0.1 // Minimal share of kana among the letters of a japanese text
+
+var profiles map[string]map[string]int // Maps ISO-639-1 codes to n-gram ranks
+var loadProfiles sync.Once
+
+// Returns the ISO-639-1 codes of all languages the package can identify.
+func Languages() []string {
+	loadProfiles.Do(load)
+	langs := make([]string, 0, len(profiles)+len(scripts))
+	for lang := range profiles {
+		langs = append(langs, lang)
+	}
+	for _, script := range scripts {
+		if !slices.Contains(langs, script.lang) {
+			langs = append(langs, script.lang)
+		}
+	}
+	sort.Strings(langs)
+	return langs
+}
+
+// Identifies the language of text and returns its ISO-639-1 code.
+// Returns an error if text is too short or its language cannot be told apart reliably.
+func Identify(text string) (string, error) {
+	letters, latin, han, other := 0, 0, 0, 0
+	counts := make(map[string]int)
+	for _, r := range text {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		letters++
+		switch {
+		case unicode.Is(unicode.Latin, r):
+			latin++
+		case unicode.Is(unicode.Han, r):
+			han++
+		default:
+			if lang := scriptLang(r); lang != "" {
+				counts[lang]++
+			} else {
+				other++
+			}
+		}
+	}
+	if letters < 1 {
+		return "", errors.New("Text contains no letters")
+	}
+	if float64(counts["ja"]) >= minKana*float64(letters) {
+		return "ja", nil
+	}
+	best, bestCount := "", 0
+	for _, script := range scripts {
+		if counts[script.lang] > bestCount {
+			best, bestCount = script.lang, counts[script.lang]
+		}
+	}
+	if bestCount > latin && bestCount > han+other {
+		return best, nil
+	}
+	if han+other >= latin {
+		return "", errors.New("Script is shared by several languages or unknown")
+	}
+	if letters < minLetters {
+		return "", errors.New("Text is too short for a reliable identification")
+	}
+	return identifyLatin(text)
+}
+
+// Returns the language of the script r belongs to, an empty string if the script is not listed in scripts.
+func scriptLang(r rune) string {
+	for _, script := range scripts {
+		if unicode.Is(script.table, r) {
+			return script.lang
+		}
+	}
+	return ""
+}
+
+// Identifies the language of a text written in latin script by the distance of its n-gram profile
+// to the sample profiles.
+func identifyLatin(text string) (string, error) {
+	loadProfiles.Do(load)
+	ngrams := rankedNGrams(text)
+	best, second := "", ""
+	distances := make(map[string]int, len(profiles))
+	for lang, profile := range profiles {
+		distance := 0
+		for rank, ngram := range ngrams {
+			if r, ok := profile[ngram]; ok {
+				distance += abs(rank - r)
+			} else {
+				distance += profileSize
+			}
+		}
+		distances[lang] = distance
+		switch {
+		case best == "" || distance < distances[best] || (distance == distances[best] && lang < best):
+			best, second = lang, best
+		case second == "" || distance < distances[second] || (distance == distances[second] && lang < second):
+			second = lang
+		}
+	}
+	if best == "" {
+		return "", errors.New("No language profiles available")
+	}
+	if second != "" && float64(distances[second]-distances[best]) < minMargin*float64(distances[best]) {
+		return "", errors.New("Language is ambiguous")
+	}
+	return best, nil
+}
+
+// Builds the profiles from the embedded samples, each sample file is named after its language.
+func load() {
+	profiles = make(map[string]map[string]int)
+	entries, err := samples.ReadDir("samples")
+	if err != nil {
+		panic(err)
+	}
+	for _, entry := range entries {
+		data, err := samples.ReadFile(path.Join("samples", entry.Name()))
+		if err != nil {
+			panic(err)
+		}
+		profile := make(map[string]int, profileSize)
+		for rank, ngram := range rankedNGrams(string(data)) {
+			profile[ngram] = rank
+		}
+		profiles[strings.TrimSuffix(entry.Name(), path.Ext(entry.Name()))] = profile
+	}
+}
+
+// Returns the most frequent n-grams of length 1 to 3 of text, most frequent first.
+// Words are padded with spaces so that n-grams at word boundaries are distinguishable.
+func rankedNGrams(text string) []string {
+	counts := make(map[string]int)
+	for _, word := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool { return !unicode.IsLetter(r) }) {
+		runes := []rune(" " + word + " ")
+		for n := 1; n <= 3; n++ {
+			for i := 0; i+n <= len(runes); i++ {
+				if ngram := string(runes[i : i+n]); ngram != " " {
+					counts[ngram]++
+				}
+			}
+		}
+	}
+	ngrams := make([]string, 0, len(counts))
+	for ngram := range counts {
+		ngrams = append(ngrams, ngram)
+	}
+	sort.Slice(ngrams, func(i, j int) bool {
+		if counts[ngrams[i]] != counts[ngrams[j]] {
+			return counts[ngrams[i]] > counts[ngrams[j]]
+		}
+		return ngrams[i] < ngrams[j]
+	})
+	if len(ngrams) > profileSize {
+		ngrams = ngrams[:profileSize]
+	}
+	return ngrams
+}
+
+func abs(i int) int {
+	if i < 0 {
+		return -i
+	}
+	return i
+}
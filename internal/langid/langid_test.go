@@ -0,0 +1,43 @@
+//This file is part of imdb2mkvtags ©2026 Jörg Walter
+
+package langid
+
+import (
+	"testing"
+)
+
+func TestIdentify(t *testing.T) {
+	texts := map[string]string{
+		"de": "Ein pensionierter Boxer muss sich ein letztes Mal seiner Vergangenheit stellen, um seine Tochter zu beschützen.",
+		"en": "A retired boxer has to face his past one last time in order to protect his daughter from a ruthless gang.",
+		"es": "Un boxeador retirado tiene que enfrentarse a su pasado una última vez para proteger a su hija.",
+		"fr": "Un boxeur à la retraite doit affronter son passé une dernière fois pour protéger sa fille d'un gang.",
+		"it": "Un pugile in pensione deve affrontare il suo passato un'ultima volta per proteggere sua figlia.",
+		"nl": "Een gepensioneerde bokser moet nog één keer zijn verleden onder ogen zien om zijn dochter te beschermen.",
+		"pt": "Um pugilista aposentado precisa enfrentar o seu passado uma última vez para proteger a sua filha.",
+		"ja": "引退したボクサーが娘を守るために過去と向き合う。",
+		"ko": "은퇴한 권투 선수가 딸을 지키기 위해 과거와 마주한다.",
+		"el": "Ένας συνταξιούχος πυγμάχος πρέπει να αντιμετωπίσει το παρελθόν του για να προστατεύσει την κόρη του.",
+	}
+	for expected, text := range texts {
+		lang, err := Identify(text)
+		if err != nil {
+			t.Errorf("Identify(%q): %s", text, err)
+		} else if lang != expected {
+			t.Errorf("Identify(%q): Expected %q, got %q", text, expected, lang)
+		}
+	}
+
+	fails := []string{"", "1984", "Das Boot", "Alien",
+		"Бывший боксёр должен в последний раз столкнуться со своим прошлым, чтобы защитить дочь.",
+		"Колишній боксер мусить востаннє зіткнутися зі своїм минулим, щоб захистити доньку.",
+		"एक सेवानिवृत्त मुक्केबाज़ को अपनी बेटी की रक्षा के लिए अतीत का सामना करना पड़ता है।",
+		"一名退役拳击手必须最后一次面对自己的过去，以保护他的女儿。",
+		"一名退役拳击手必须最后一次面对自己的过去，以保护他的女儿ノ。",
+	}
+	for _, fail := range fails {
+		if lang, err := Identify(fail); err == nil {
+			t.Errorf("Expected an error when calling Identify with input string %q, got %q", fail, lang)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+//This file is part of imdb2mkvtags ©2026 Jörg Walter
+
+package lcconv
+
+// Maps Alpha-2 country codes to the ISO-639-1 code of the country's predominant language.
+// Countries without a clearly predominant language are omitted.
+var countryLanguages = map[string]string{
+	"AE": "ar", "AR": "es", "AT": "de", "AU": "en", "BA": "bs", "BD": "bn",
+	"BG": "bg", "BO": "es", "BR": "pt", "CA": "en", "CL": "es", "CN": "zh",
+	"CO": "es", "CR": "es", "CU": "es", "CZ": "cs", "DE": "de", "DK": "da",
+	"DO": "es", "DZ": "ar", "EC": "es", "EE": "et", "EG": "ar", "ES": "es",
+	"FI": "fi", "FR": "fr", "GB": "en", "GR": "el", "GT": "es", "HK": "zh",
+	"HR": "hr", "HU": "hu", "ID": "id", "IE": "en", "IL": "he", "IN": "hi",
+	"IQ": "ar", "IR": "fa", "IS": "is", "IT": "it", "JO": "ar", "JP": "ja",
+	"KR": "ko", "KZ": "kk", "LB": "ar", "LT": "lt", "LU": "lb", "LV": "lv",
+	"MA": "ar", "MK": "mk", "MX": "es", "MY": "ms", "NG": "en", "NL": "nl",
+	"NO": "no", "NZ": "en", "PE": "es", "PH": "tl", "PK": "ur", "PL": "pl",
+	"PT": "pt", "PY": "es", "RO": "ro", "RS": "sr", "RU": "ru", "SA": "ar",
+	"SE": "sv", "SG": "en", "SI": "sl", "SK": "sk", "TH": "th", "TN": "ar",
+	"TR": "tr", "TW": "zh", "UA": "uk", "US": "en", "UY": "es", "VE": "es",
+	"VN": "vi", "ZA": "en",
+}
+
+// Returns the ISO-639-1 code of the predominant language of the country with the given Alpha-2 code.
+// Returns an empty string if the country is unknown or has no predominant language.
+func CountryLanguage(alpha2 string) string {
+	return countryLanguages[alpha2]
+}
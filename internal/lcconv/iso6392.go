@@ -0,0 +1,110 @@
+//This file is part of imdb2mkvtags ©2026 Jörg Walter
+
+package lcconv
+
+import (
+	"strings"
+)
+
+// Maps ISO-639-1 codes to ISO 639-2/B codes.
+var iso6392B = map[string]string{
+	"aa": "aar", "ab": "abk", "ae": "ave", "af": "afr", "ak": "aka", "am": "amh", "an": "arg", "ar": "ara",
+	"as": "asm", "av": "ava", "ay": "aym", "az": "aze", "ba": "bak", "be": "bel", "bg": "bul", "bh": "bih",
+	"bi": "bis", "bm": "bam", "bn": "ben", "bo": "tib", "br": "bre", "bs": "bos", "ca": "cat", "ce": "che",
+	"ch": "cha", "co": "cos", "cr": "cre", "cs": "cze", "cu": "chu", "cv": "chv", "cy": "wel", "da": "dan",
+	"de": "ger", "dv": "div", "dz": "dzo", "ee": "ewe", "el": "gre", "en": "eng", "eo": "epo", "es": "spa",
+	"et": "est", "eu": "baq", "fa": "per", "ff": "ful", "fi": "fin", "fj": "fij", "fo": "fao", "fr": "fre",
+	"fy": "fry", "ga": "gle", "gd": "gla", "gl": "glg", "gn": "grn", "gu": "guj", "gv": "glv", "ha": "hau",
+	"he": "heb", "hi": "hin", "ho": "hmo", "hr": "hrv", "ht": "hat", "hu": "hun", "hy": "arm", "hz": "her",
+	"ia": "ina", "id": "ind", "ie": "ile", "ig": "ibo", "ii": "iii", "ik": "ipk", "io": "ido", "is": "ice",
+	"it": "ita", "iu": "iku", "ja": "jpn", "jv": "jav", "ka": "geo", "kg": "kon", "ki": "kik", "kj": "kua",
+	"kk": "kaz", "kl": "kal", "km": "khm", "kn": "kan", "ko": "kor", "kr": "kau", "ks": "kas", "ku": "kur",
+	"kv": "kom", "kw": "cor", "ky": "kir", "la": "lat", "lb": "ltz", "lg": "lug", "li": "lim", "ln": "lin",
+	"lo": "lao", "lt": "lit", "lu": "lub", "lv": "lav", "mg": "mlg", "mh": "mah", "mi": "mao", "mk": "mac",
+	"ml": "mal", "mn": "mon", "mr": "mar", "ms": "may", "mt": "mlt", "my": "bur", "na": "nau", "nb": "nob",
+	"nd": "nde", "ne": "nep", "ng": "ndo", "nl": "dut", "nn": "nno", "no": "nor", "nr": "nbl", "nv": "nav",
+	"ny": "nya", "oc": "oci", "oj": "oji", "om": "orm", "or": "ori", "os": "oss", "pa": "pan", "pi": "pli",
+	"pl": "pol", "ps": "pus", "pt": "por", "qu": "que", "rm": "roh", "rn": "run", "ro": "rum", "ru": "rus",
+	"rw": "kin", "sa": "san", "sc": "srd", "sd": "snd", "se": "sme", "sg": "sag", "si": "sin", "sk": "slo",
+	"sl": "slv", "sm": "smo", "sn": "sna", "so": "som", "sq": "alb", "sr": "srp", "ss": "ssw", "st": "sot",
+	"su": "sun", "sv": "swe", "sw": "swa", "ta": "tam", "te": "tel", "tg": "tgk", "th": "tha", "ti": "tir",
+	"tk": "tuk", "tl": "tgl", "tn": "tsn", "to": "ton", "tr": "tur", "ts": "tso", "tt": "tat", "tw": "twi",
+	"ty": "tah", "ug": "uig", "uk": "ukr", "ur": "urd", "uz": "uzb", "ve": "ven", "vi": "vie", "vo": "vol",
+	"wa": "wln", "wo": "wol", "xh": "xho", "yi": "yid", "yo": "yor", "za": "zha", "zh": "chi", "zu": "zul",
+}
+
+// Maps the ISO 639-2/T codes that differ from their ISO 639-2/B counterparts to the latter.
+var iso6392TtoB = map[string]string{
+	"bod": "tib", "ces": "cze", "cym": "wel", "deu": "ger", "ell": "gre", "eus": "baq", "fas": "per",
+	"fra": "fre", "hye": "arm", "isl": "ice", "kat": "geo", "mkd": "mac", "mri": "mao", "msa": "may",
+	"mya": "bur", "nld": "dut", "ron": "rum", "slk": "slo", "sqi": "alb", "zho": "chi",
+}
+
+// ISO 639-2 codes of languages without ISO-639-1 code, including collective and special codes.
+// The reserved range qaa-qtz is not included.
+var iso6392Other = strings.Fields(`
+	ace ach ada ady afa afh ain akk ale alg alt ang anp apa arc arn arp art arw ast ath aus awa
+	bad bai bal ban bas bat bej bem ber bho bik bin bla bnt bra btk bua bug byn
+	cad cai car cau ceb cel chb chg chk chm chn cho chp chr chy cmc cnr cop cpe cpf cpp crh crp csb cus
+	dak dar day del den dgr din doi dra dsb dua dum dyu efi egy eka elx enm ewo
+	fan fat fil fiu fon frm fro frr frs fur gaa gay gba gem gez gil gmh goh gon gor got grb grc gsw gwi
+	hai haw hil him hit hmn hsb hup iba ijo ilo inc ine inh ira iro jbo jpr jrb
+	kaa kab kac kam kar kaw kbd kha khi kho kmb kok kos kpe krc krl kro kru kum kut
+	lad lah lam lez lol loz lua lui lun luo lus
+	mad mag mai mak man map mas mdf mdr men mga mic min mis mkh mnc mni mno moh mos mul mun mus mwl mwr myn myv
+	nah nai nap nds new nia nic niu nog non nqo nso nub nwc nym nyn nyo nzi osa ota oto
+	paa pag pal pam pap pau peo phi phn pon pra pro raj rap rar roa rom rup
+	sad sah sai sal sam sas sat scn sco sel sem sga sgn shn sid sio sit sla sma smi smj smn sms snk sog son srn srr ssa suk sus sux syc syr
+	tai tem ter tet tig tiv tkl tlh tli tmh tog tpi tsi tum tup tut tvl tyv udm uga umb und vai vot
+	wak wal war was wen xal yao yap ypk zap zbl zen zgh znd zun zxx zza
+`)
+
+// Maps ISO 639-2/B codes to ISO-639-1 codes.
+var iso6392BtoISO6391 = make(map[string]string, len(iso6392B))
+
+// Contains all ISO 639-2/B codes.
+var iso6392BCodes = make(map[string]bool, len(iso6392B)+len(iso6392Other))
+
+func init() {
+	for iso6391, b := range iso6392B {
+		iso6392BtoISO6391[b] = iso6391
+		iso6392BCodes[b] = true
+	}
+	for _, b := range iso6392Other {
+		iso6392BCodes[b] = true
+	}
+}
+
+// Returns the ISO 639-2/B code for the language of the given language tag, e.g. "ger" for "de-DE".
+// Three-letter language subtags are returned as ISO 639-2/B code.
+// Returns an empty string if the tag's language is not part of ISO 639-2.
+func ISO6392B(tag string) string {
+	lang, _, _ := strings.Cut(strings.ToLower(tag), "-")
+	switch len(lang) {
+	case 2:
+		return iso6392B[lang]
+	case 3:
+		if b, ok := iso6392TtoB[lang]; ok {
+			return b
+		}
+		if iso6392BCodes[lang] {
+			return lang
+		}
+	}
+	return ""
+}
+
+// Reports whether code is an ISO 639-2/B or ISO 639-2/T code.
+func isISO6392(code string) bool {
+	_, ok := iso6392TtoB[code]
+	return ok || iso6392BCodes[code]
+}
+
+// Returns the ISO-639-1 code for an ISO 639-2/B or ISO 639-2/T code.
+// Returns an empty string if the language has no ISO-639-1 code.
+func iso6391For6392(code string) string {
+	if b, ok := iso6392TtoB[code]; ok {
+		code = b
+	}
+	return iso6392BtoISO6391[code]
+}
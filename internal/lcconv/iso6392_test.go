@@ -0,0 +1,36 @@
+//This file is part of imdb2mkvtags ©2026 Jörg Walter
+
+package lcconv
+
+import (
+	"github.com/emvi/iso-639-1"
+	"testing"
+)
+
+func TestISO6392B(t *testing.T) {
+	codes := map[string]string{
+		"de":      "ger",
+		"de-DE":   "ger",
+		"fr-CA":   "fre",
+		"en":      "eng",
+		"zh-Hant": "chi",
+		"deu":     "ger",
+		"gsw-CH":  "gsw",
+		"ger":     "ger",
+		"und":     "und",
+		"xyz":     "",
+		"qaa":     "",
+		"":        "",
+		"xx":      "",
+	}
+	for input, expected := range codes {
+		if res := ISO6392B(input); res != expected {
+			t.Errorf("ISO6392B(%q): Expected \"%s\", got \"%s\"", input, expected, res)
+		}
+	}
+	for _, code := range iso6391.Codes {
+		if ISO6392B(code) == "" {
+			t.Errorf("No ISO 639-2/B code for ISO-639-1 code %q", code)
+		}
+	}
+}
@@ -1,65 +1,188 @@
-//This file is part of imdb2mkvtags ©2021 Jörg Walter
+//This file is part of imdb2mkvtags ©2021-2026 Jörg Walter
 
 package lcconv
 
 import (
 	"errors"
+	"fmt"
 	"github.com/biter777/countries"
 	"github.com/emvi/iso-639-1"
 	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 )
 
-var regexpCountryCode = regexp.MustCompile("^[A-Z]{2}$")
+var regexpLanguage = regexp.MustCompile("^[a-z]{2,3}$")
+var regexpScript = regexp.MustCompile("^[a-z]{4}$")
+var regexpRegion = regexp.MustCompile("^(?:[a-z]{2}|\\d{3})$")
+var regexpVariant = regexp.MustCompile("^(?:[a-z\\d]{5,8}|\\d[a-z\\d]{3})$")
+var regexpQuality = regexp.MustCompile("^\\s*[qQ]=(0(?:\\.\\d{0,3})?|1(?:\\.0{0,3})?)$")
 
-// Provides information about language & country
+// Deprecated language subtags and their preferred values according to the IANA language subtag registry.
+var languageAliases = map[string]string{
+	"in": "id",
+	"iw": "he",
+	"ji": "yi",
+	"jw": "jv",
+	"mo": "ro",
+}
+
+// Provides information about a BCP 47 language tag of the form language[-script][-region][-variant]...
+// Extended language subtags, extensions and private use subtags are not supported.
 type LngCntry struct {
-	iso6391 string //ISO-639-1 language code
-	cc      countries.CountryCode
+	language string                // ISO-639 language code
+	script   string                // ISO 15924 script code, empty if not set
+	region   string                // Alpha-2 country code or UN M.49 area code, empty if not set
+	variants []string              // Variant subtags
+	cc       countries.CountryCode // Country of region, countries.Unknown if region is not an Alpha-2 code
+	quality  float64               // Weight for content negotiation, only valid if weighted is true
+	weighted bool
 }
 
+// Parses the BCP 47 language tag input. Subtags are case-insensitive and will be canonicalized,
+// e.g. "ZH-hant-tw" becomes "zh-Hant-TW".
 func NewLngCntry(input string) (*LngCntry, error) {
-	splitted := strings.Split(input, "-")
-	if len(splitted) != 2 {
-		return nil, errors.New("Expected language and country separated by \"-\"")
+	if input == "" {
+		return nil, errors.New("Empty language tag")
 	}
-	lng := splitted[0]
-	cntry := splitted[1]
+	subtags := strings.Split(strings.ToLower(input), "-")
+	lng := &LngCntry{cc: countries.Unknown}
 
-	if !iso6391.ValidCode(lng) {
+	// language
+	lng.language = subtags[0]
+	if !regexpLanguage.MatchString(lng.language) {
 		return nil, errors.New("Invalid language code")
 	}
+	if alias, ok := languageAliases[lng.language]; ok {
+		lng.language = alias
+	}
+	// BCP 47 requires the shortest code, e.g. "de" instead of "ger" or "deu"
+	if iso6391 := iso6391For6392(lng.language); iso6391 != "" {
+		lng.language = iso6391
+	}
+	if len(lng.language) == 2 && !iso6391.ValidCode(lng.language) || len(lng.language) == 3 && !isISO6392(lng.language) {
+		return nil, errors.New("Invalid language code")
+	}
+	subtags = subtags[1:]
+
+	// script
+	if len(subtags) > 0 && regexpScript.MatchString(subtags[0]) {
+		lng.script = strings.ToUpper(subtags[0][:1]) + subtags[0][1:]
+		subtags = subtags[1:]
+	}
 
-	if !regexpCountryCode.MatchString(cntry) {
-		return nil, errors.New("Invalid country code")
+	// region
+	if len(subtags) > 0 && regexpRegion.MatchString(subtags[0]) {
+		lng.region = strings.ToUpper(subtags[0])
+		if len(lng.region) == 2 {
+			lng.cc = countries.ByName(lng.region)
+			if !lng.cc.IsValid() || lng.cc.Alpha2() != lng.region {
+				return nil, errors.New("Invalid country code")
+			}
+		}
+		subtags = subtags[1:]
 	}
 
-	cc := countries.ByName(cntry)
-	if cc == countries.Unknown {
-		return nil, errors.New("Invalid country code")
+	// variants
+	for _, variant := range subtags {
+		if !regexpVariant.MatchString(variant) {
+			return nil, fmt.Errorf("Invalid subtag %q", variant)
+		}
+		if slices.Contains(lng.variants, variant) {
+			return nil, fmt.Errorf("Duplicate variant %q", variant)
+		}
+		lng.variants = append(lng.variants, variant)
 	}
+	return lng, nil
+}
 
-	return &LngCntry{
-		iso6391: lng,
-		cc:      cc,
-	}, nil
+// Parses a language tag with an optional quality weight as used in the Accept-Language header field, e.g. "de-DE;q=0.8".
+func NewWeightedLngCntry(input string) (*LngCntry, error) {
+	tag, weight, weighted := strings.Cut(input, ";")
+	lng, err := NewLngCntry(strings.TrimSpace(tag))
+	if err != nil {
+		return nil, err
+	}
+	if weighted {
+		matches := regexpQuality.FindStringSubmatch(weight)
+		if matches == nil {
+			return nil, fmt.Errorf("Invalid quality weight %q", weight)
+		}
+		lng.quality, err = strconv.ParseFloat(matches[1], 64)
+		if err != nil {
+			return nil, err
+		}
+		lng.weighted = true
+	}
+	return lng, nil
+}
+
+// Returns the tag's quality weight and true if the weight was set explicitly.
+func (r *LngCntry) Quality() (float64, bool) {
+	return r.quality, r.weighted
+}
+
+// Returns the tag reduced to its language subtag, e.g. "de" for "de-DE".
+// Returns nil if the tag only consists of the language subtag.
+func (r *LngCntry) Base() *LngCntry {
+	if r.script == "" && r.region == "" && len(r.variants) == 0 {
+		return nil
+	}
+	return &LngCntry{language: r.language, cc: countries.Unknown}
+}
+
+// Returns the language subtag, an ISO-639-1 code or a three-letter ISO-639 code for languages without one.
+func (r *LngCntry) Language() string {
+	return r.language
 }
 
 // Returns the 2-character language code.
+// Returns an empty string if the language has no ISO-639-1 code.
 func (r *LngCntry) ISO6391() string {
-	return r.iso6391
+	if len(r.language) != 2 {
+		return ""
+	}
+	return r.language
+}
+
+// Returns the ISO 15924 script code, e.g. "Hant". Returns an empty string if the tag has no script subtag.
+func (r *LngCntry) Script() string {
+	return r.script
+}
+
+// Returns the region subtag, an Alpha-2 country code or an UN M.49 area code like "419".
+// Returns an empty string if the tag has no region subtag.
+func (r *LngCntry) Region() string {
+	return r.region
 }
 
 // Returns the Alpha-2 country code.
+// Returns an empty string if the tag's region is not a country.
 func (r *LngCntry) Alpha2() string {
+	if !r.cc.IsValid() {
+		return ""
+	}
 	return r.cc.Alpha2()
 }
 
 // Returns the Alpha-3 country code.
+// Returns an empty string if the tag's region is not a country.
 func (r *LngCntry) Alpha3() string {
+	if !r.cc.IsValid() {
+		return ""
+	}
 	return r.cc.Alpha3()
 }
 
+// Returns the canonical form of the language tag.
+func (r *LngCntry) String() string {
+	subtags := append([]string{r.language}, r.script, r.region)
+	subtags = append(subtags, r.variants...)
+	return strings.Join(slices.DeleteFunc(subtags, func(s string) bool { return s == "" }), "-")
+}
+
+// Returns the language tag for the Accept-Language header field.
 func (r *LngCntry) HttpHeader() string {
-	return r.ISO6391() + "-" + r.Alpha2()
+	return r.String()
 }
@@ -23,7 +23,7 @@ func TestNewLngCntry(t *testing.T) {
 		t.Errorf("Expected \"DEU\", got \"%s\"", res)
 	}
 
-	fails := []string{"DE-de", "en-Us", "En-US", "en-us", "de CH", "en_CA", "a-B", "abc-def", "ab-c", "de-Ö", "ab-CD-ef"}
+	fails := []string{"", "de CH", "en_CA", "a-B", "abc-def", "ab-c", "de-Ö", "ab-CD-ef", "xx-DE", "xyz", "qaa-DE", "de-XX", "de-DE-", "de-1996-1996", "en-US;q=1", "de-DE\r\nX-Injected: 1"}
 
 	for _, fail := range fails {
 		_, err := NewLngCntry(fail)
@@ -32,3 +32,86 @@ func TestNewLngCntry(t *testing.T) {
 		}
 	}
 }
+
+func TestNewLngCntryCanonical(t *testing.T) {
+	tags := map[string]string{
+		"de":             "de",
+		"de-DE":          "de-DE",
+		"DE-de":          "de-DE",
+		"en-us":          "en-US",
+		"En-US":          "en-US",
+		"zh-Hant-TW":     "zh-Hant-TW",
+		"ZH-HANT-tw":     "zh-Hant-TW",
+		"sr-Latn":        "sr-Latn",
+		"es-419":         "es-419",
+		"gsw-CH":         "gsw-CH",
+		"iw-IL":          "he-IL",
+		"deu-DE":         "de-DE",
+		"ger":            "de",
+		"de-CH-1901":     "de-CH-1901",
+		"sl-rozaj-biske": "sl-rozaj-biske",
+	}
+	for input, expected := range tags {
+		lng, err := NewLngCntry(input)
+		if err != nil {
+			t.Errorf("NewLngCntry(%q): %s", input, err)
+			continue
+		}
+		if res := lng.String(); res != expected {
+			t.Errorf("NewLngCntry(%q): Expected \"%s\", got \"%s\"", input, expected, res)
+		}
+	}
+
+	lng, err := NewLngCntry("zh-Hant-TW")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lng.Language() != "zh" || lng.Script() != "Hant" || lng.Region() != "TW" || lng.Alpha2() != "TW" {
+		t.Errorf("Unexpected subtags for \"zh-Hant-TW\": %q %q %q %q", lng.Language(), lng.Script(), lng.Region(), lng.Alpha2())
+	}
+	lng, err = NewLngCntry("gsw")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lng.ISO6391() != "" || lng.Alpha2() != "" || lng.Alpha3() != "" {
+		t.Errorf("Expected empty ISO-639-1 and country codes for \"gsw\", got %q %q %q", lng.ISO6391(), lng.Alpha2(), lng.Alpha3())
+	}
+}
+
+func TestNewWeightedLngCntry(t *testing.T) {
+	weights := map[string]float64{
+		"de-DE;q=1":     1,
+		"de-DE;q=0.5":   0.5,
+		"en;q=0.125":    0.125,
+		"en-US; q=0":    0,
+		"fr-CA;Q=1.000": 1,
+	}
+	for input, expected := range weights {
+		lng, err := NewWeightedLngCntry(input)
+		if err != nil {
+			t.Errorf("NewWeightedLngCntry(%q): %s", input, err)
+			continue
+		}
+		if quality, weighted := lng.Quality(); !weighted || quality != expected {
+			t.Errorf("NewWeightedLngCntry(%q): Expected weight %v, got %v (weighted: %t)", input, expected, quality, weighted)
+		}
+	}
+	if _, weighted := mustWeighted(t, "de-DE").Quality(); weighted {
+		t.Error("Expected \"de-DE\" to be unweighted")
+	}
+
+	fails := []string{"de-DE;q=1.5", "de-DE;q=0.1234", "de-DE;x=1", "de-DE;q=", "de-DE;", "xx;q=1"}
+	for _, fail := range fails {
+		if _, err := NewWeightedLngCntry(fail); err == nil {
+			t.Errorf("Expected an error return when calling NewWeightedLngCntry with input string \"%s\"", fail)
+		}
+	}
+}
+
+func mustWeighted(t *testing.T, input string) *LngCntry {
+	lng, err := NewWeightedLngCntry(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return lng
+}
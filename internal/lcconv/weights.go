@@ -0,0 +1,74 @@
+//This file is part of imdb2mkvtags ©2026 Jörg Walter
+
+package lcconv
+
+import (
+	"fmt"
+	"math"
+	"slices"
+)
+
+// A language tag and its effective weight for content negotiation.
+type WeightedTag struct {
+	Tag     string
+	Quality float64
+	Listed  bool // False for base languages that were added as fallback
+}
+
+// Returns the language tags of lang in the given order with their effective weights, including the base language fallbacks.
+// Languages without an explicit weight get the previous language's weight reduced by 0.1, at least 0.1.
+// Each language with a region or script is followed by its base language if the base language is not listed.
+func EffectiveWeights(lang ...*LngCntry) []WeightedTag {
+	entries := make([]WeightedTag, 0, len(lang)*2)
+	contains := func(tag string) bool {
+		return slices.ContainsFunc(entries, func(e WeightedTag) bool { return e.Tag == tag })
+	}
+	next := func() float64 {
+		if len(entries) == 0 {
+			return 1
+		}
+		return math.Max(math.Round((entries[len(entries)-1].Quality-0.1)*10)/10, 0.1)
+	}
+	listed := make([]string, len(lang))
+	for i, v := range lang {
+		if v == nil {
+			panic("argument \"lang\" cannot be nil")
+		}
+		listed[i] = v.String()
+	}
+	for _, v := range lang {
+		if contains(v.String()) {
+			continue
+		}
+		quality, weighted := v.Quality()
+		if !weighted {
+			quality = next()
+		}
+		entries = append(entries, WeightedTag{Tag: v.String(), Quality: quality, Listed: true})
+		if base := v.Base(); base != nil && !slices.Contains(listed, base.String()) && !contains(base.String()) {
+			quality := next()
+			if quality > entries[len(entries)-1].Quality {
+				quality = entries[len(entries)-1].Quality
+			}
+			entries = append(entries, WeightedTag{Tag: base.String(), Quality: quality})
+		}
+	}
+	return entries
+}
+
+// Returns an error if the effective weight of a language is higher than the one of the language before it.
+func CheckWeights(lang ...*LngCntry) error {
+	entries := EffectiveWeights(lang...)
+	var previous *WeightedTag
+	for i := range entries {
+		if !entries[i].Listed {
+			continue
+		}
+		if previous != nil && entries[i].Quality > previous.Quality {
+			return fmt.Errorf("Language %s: Weight %g is higher than the weight %g of language %s",
+				entries[i].Tag, entries[i].Quality, previous.Quality, previous.Tag)
+		}
+		previous = &entries[i]
+	}
+	return nil
+}
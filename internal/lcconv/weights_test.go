@@ -0,0 +1,36 @@
+//This file is part of imdb2mkvtags ©2026 Jörg Walter
+
+package lcconv
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheckWeights(t *testing.T) {
+	inputs := map[string]bool{
+		"de-DE:en-US":           true,
+		"de-DE;q=1:en-US;q=0.5": true,
+		"de-DE:en;q=0.95":       true,
+		"de-DE:en-US:fr;q=0.8":  true,
+		"de-DE:en-US:fr;q=0.95": false,
+		"de-DE;q=0.5:en;q=0.6":  false,
+		"de;q=0.5:en":           true,
+	}
+	for input, valid := range inputs {
+		raw := strings.Split(input, ":")
+		langs := make([]*LngCntry, len(raw))
+		for i, s := range raw {
+			lang, err := NewWeightedLngCntry(s)
+			if err != nil {
+				t.Fatalf("NewWeightedLngCntry(%q): %s", s, err)
+			}
+			langs[i] = lang
+		}
+		if err := CheckWeights(langs...); valid && err != nil {
+			t.Errorf("CheckWeights(%q): %s", input, err)
+		} else if !valid && err == nil {
+			t.Errorf("CheckWeights(%q): Expected an error", input)
+		}
+	}
+}
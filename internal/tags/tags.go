@@ -6,20 +6,66 @@ import (
 	"encoding/xml"
 	"fmt"
 	"github.com/jwdev42/imdb2mkvtags/internal/global"
+	"github.com/jwdev42/imdb2mkvtags/internal/lcconv"
 	"github.com/jwdev42/imdb2mkvtags/internal/util/dynamic"
 	ixml "github.com/jwdev42/imdb2mkvtags/internal/xml"
 	"io"
+	"math"
 	"reflect"
+	"slices"
+	"strconv"
+	"strings"
 )
 
+// Selects the elements multilingual tags carry their language in.
+type LanguageMode int
+
+const (
+	LanguageIETF   LanguageMode = iota // TagLanguageIETF only
+	LanguageBoth                       // TagLanguage (ISO 639-2/B) and TagLanguageIETF
+	LanguageLegacy                     // TagLanguage (ISO 639-2/B) only
+)
+
+var languageModes = []string{"ietf", "both", "legacy"}
+
+// Key of the LanguageMode stored in the XmlWriter by WriteTags.
+type languageModeKey struct{}
+
+// Returns the language mode of the document written by xw.
+func languageModeOf(xw *ixml.XmlWriter) LanguageMode {
+	if mode, ok := xw.Value(languageModeKey{}).(LanguageMode); ok {
+		return mode
+	}
+	return LanguageIETF
+}
+
+// Implements flag.Value.
+func (r *LanguageMode) Set(value string) error {
+	i := slices.Index(languageModes, value)
+	if i < 0 {
+		return fmt.Errorf("Unknown language mode %q, expected one of %s", value, strings.Join(languageModes, ", "))
+	}
+	*r = LanguageMode(i)
+	return nil
+}
+
+// Implements flag.Value.
+func (r *LanguageMode) String() string {
+	if r == nil || int(*r) >= len(languageModes) {
+		return languageModes[LanguageIETF]
+	}
+	return languageModes[*r]
+}
+
 type TagWriter interface {
 	WriteTag(*ixml.XmlWriter, string) error //Writes the tag's content to an xml file
 	CheckTag() error                        //Returns an error if the tag is missing mandatory data
 }
 
 type Actor struct {
-	Name      string
-	Character string
+	Person
+	Characters []*Character `mkv:"CHARACTER"`
+	Episodes   UniLingual   `mkv:"EPISODES"` // Number of episodes the actor appeared in, series only
 }
 
 func (r *Actor) CheckTag() error {
@@ -33,12 +79,11 @@ func (r *Actor) WriteTag(xw *ixml.XmlWriter, name string) error {
 	if err := r.CheckTag(); err != nil {
 		return err
 	}
-	var fc func(*ixml.XmlWriter) error
-	if r.Character != "" {
-		character := make([][2]string, 0, 2)
-		character = append(character, [2]string{"Name", "CHARACTER"})
-		character = append(character, [2]string{"String", r.Character})
-		fc = ixml.GenWriteTagWithSubtags("Simple", character, nil)
+	fc := func(xw *ixml.XmlWriter) error {
+		if err := writeTaggedFields(xw, r); err != nil {
+			return err
+		}
+		return writeTaggedFields(xw, &r.Person)
 	}
 	actor := make([][2]string, 0, 2)
 	actor = append(actor, [2]string{"Name", name})
@@ -46,6 +91,44 @@ func (r *Actor) WriteTag(xw *ixml.XmlWriter, name string) error {
 	return ixml.WriteTagWithSubtags(xw, "Simple", actor, fc)
 }
 
+// A role played by an actor.
+type Character struct {
+	Name string
+	ID   UniLingual `mkv:"IMDB_CHARACTER_ID"` // Only set for characters that have an IMDB character ID, e.g. "ch0000001"
+	URL  UniLingual `mkv:"URL"`
+}
+
+func (r *Character) CheckTag() error {
+	if len(r.Name) < 1 {
+		return fmt.Errorf("Name not set for character")
+	}
+	return nil
+}
+
+func (r *Character) WriteTag(xw *ixml.XmlWriter, name string) error {
+	return writeNestedTag(xw, name, r.Name, r)
+}
+
+// A cast or crew member, the person's IMDB details are written as nested tags.
+type Person struct {
+	Name    string
+	Billing UniLingual   `mkv:"BILLING"`      // Position in the credits, starting with 1
+	ID      UniLingual   `mkv:"IMDB_NAME_ID"` // IMDB name ID, e.g. "nm0000216"
+	Notes   []UniLingual `mkv:"NOTE"`         // Annotations like "uncredited" or "voice"
+	URL     UniLingual   `mkv:"URL"`
+}
+
+func (r *Person) CheckTag() error {
+	if len(r.Name) < 1 {
+		return fmt.Errorf("Name not set for person")
+	}
+	return nil
+}
+
+func (r *Person) WriteTag(xw *ixml.XmlWriter, name string) error {
+	return writeNestedTag(xw, name, r.Name, r)
+}
+
 type UniLingual string
 
 func (r UniLingual) CheckTag() error {
@@ -81,19 +164,31 @@ func (r *MultiLingual) WriteTag(xw *ixml.XmlWriter, name string) error {
 	if err := r.CheckTag(); err != nil {
 		return err
 	}
-	subtags := make([][2]string, 0, 3)
+	subtags := make([][2]string, 0, 4)
 	subtags = append(subtags, [2]string{"Name", name})
 	subtags = append(subtags, [2]string{"String", r.Text})
 	if r.Lang != "" {
-		subtags = append(subtags, [2]string{"TagLanguageIETF", r.Lang})
+		mode := languageModeOf(xw)
+		if mode != LanguageIETF {
+			if legacy := lcconv.ISO6392B(r.Lang); legacy != "" {
+				subtags = append(subtags, [2]string{"TagLanguage", legacy})
+			} else if mode == LanguageLegacy {
+				global.Log.Debugf("Tag %s: Language %q has no ISO 639-2/B code, writing \"und\"", name, r.Lang)
+				subtags = append(subtags, [2]string{"TagLanguage", "und"})
+			}
+		}
+		if mode != LanguageLegacy {
+			subtags = append(subtags, [2]string{"TagLanguageIETF", r.Lang})
+		}
 	}
 	return ixml.WriteTagWithSubtags(xw, "Simple", subtags, nil)
 }
 
 type Country struct {
-	Name      string
-	nonempty  bool
-	LawRating UniLingual `mkv:"LAW_RATING"`
+	Name         string
+	nonempty     bool
+	DateReleased UniLingual `mkv:"DATE_RELEASED"`
+	LawRating    UniLingual `mkv:"LAW_RATING"`
 }
 
 func (r *Country) SetFieldCallback(name string, callback interface{}) {
@@ -122,104 +217,401 @@ func (r *Country) WriteTag(xw *ixml.XmlWriter, name string) error {
 	if name != "COUNTRY" {
 		panic("Country's method \"WriteTag\" must be called with name == \"COUNTRY\"")
 	}
-	if err := xw.EncodeToken(ixml.NewStartElementSimple("Simple")); err != nil {
-		return err
+	return writeNestedTag(xw, name, r.Name, r)
+}
+
+// Content advisories of IMDB's parental guide, each rated as None, Mild, Moderate or Severe.
+type ParentalGuide struct {
+	nonempty    bool
+	Alcohol     UniLingual `mkv:"ALCOHOL_DRUGS_SMOKING"`
+	Frightening UniLingual `mkv:"FRIGHTENING_INTENSE_SCENES"`
+	Nudity      UniLingual `mkv:"SEX_AND_NUDITY"`
+	Profanity   UniLingual `mkv:"PROFANITY"`
+	Violence    UniLingual `mkv:"VIOLENCE_AND_GORE"`
+}
+
+func (r *ParentalGuide) SetFieldCallback(name string, callback interface{}) {
+	if err := dynamic.SetStructFieldCallback(name, r, callback); err != nil {
+		global.Log.Error(fmt.Errorf("ParentalGuide: Could not set field \"%s\": %s", name, err))
+	} else {
+		r.nonempty = true
 	}
+}
 
-	//Start Name tag
-	if err := xw.EncodeToken(ixml.NewStartElementSimple("Name")); err != nil {
-		return err
+func (r *ParentalGuide) IsEmpty() bool {
+	return !r.nonempty
+}
+
+func (r *ParentalGuide) CheckTag() error {
+	if r.IsEmpty() {
+		return fmt.Errorf("Parental guide does not contain any payload")
 	}
-	if err := xw.WriteText([]byte(name)); err != nil {
-		return err
+	return nil
+}
+
+func (r *ParentalGuide) WriteTag(xw *ixml.XmlWriter, name string) error {
+	return writeNestedTag(xw, name, "", r)
+}
+
+// Describes the original version of the movie, e.g. its title in the original language.
+type Original struct {
+	Titles []MultiLingual `mkv:"TITLE"`
+}
+
+func (r *Original) CheckTag() error {
+	if len(r.Titles) < 1 {
+		return fmt.Errorf("Original does not contain any payload")
 	}
-	if err := xw.CloseElement(); err != nil {
-		return err
+	return nil
+}
+
+func (r *Original) WriteTag(xw *ixml.XmlWriter, name string) error {
+	return writeNestedTag(xw, name, "", r)
+}
+
+// A rating normalized to the range 0 - 5 as required by matroska.
+// The raw rating and its source are written as nested tags.
+type Rating struct {
+	Normalized string
+	Best       UniLingual `mkv:"RATING_BEST"`
+	Source     UniLingual `mkv:"RATING_SOURCE"`
+	Value      UniLingual `mkv:"RATING_VALUE"`
+	Votes      UniLingual `mkv:"RATING_VOTES"`
+}
+
+// Creates a rating of the given source whose value ranges from 0 to best.
+// The vote count will be omitted if votes is not positive.
+func NewRating(source string, value, best float64, votes int) *Rating {
+	rating := &Rating{
+		Normalized: strconv.FormatFloat(math.Round(value/best*5*100)/100, 'f', -1, 64),
+		Best:       UniLingual(strconv.FormatFloat(best, 'f', -1, 64)),
+		Source:     UniLingual(source),
+		Value:      UniLingual(strconv.FormatFloat(value, 'f', -1, 64)),
+	}
+	if votes > 0 {
+		rating.Votes = UniLingual(strconv.Itoa(votes))
 	}
-	//End Name tag
+	return rating
+}
 
-	//Start String tag
-	if err := xw.EncodeToken(ixml.NewStartElementSimple("String")); err != nil {
-		return err
+func (r *Rating) CheckTag() error {
+	if len(r.Normalized) < 1 {
+		return fmt.Errorf("Rating is empty")
 	}
-	if err := xw.WriteText([]byte(r.Name)); err != nil {
-		return err
+	return nil
+}
+
+func (r *Rating) WriteTag(xw *ixml.XmlWriter, name string) error {
+	return writeNestedTag(xw, name, r.Normalized, r)
+}
+
+// A runtime in minutes of a version of the movie.
+type Runtime struct {
+	Minutes string
+	Version UniLingual `mkv:"VERSION"`
+}
+
+func (r *Runtime) CheckTag() error {
+	if len(r.Minutes) < 1 {
+		return fmt.Errorf("Runtime is empty")
 	}
-	if err := xw.CloseElement(); err != nil {
-		return err
+	return nil
+}
+
+func (r *Runtime) WriteTag(xw *ixml.XmlWriter, name string) error {
+	return writeNestedTag(xw, name, r.Minutes, r)
+}
+
+// Technical specifications of the movie.
+type TechSpecs struct {
+	nonempty        bool
+	AspectRatios    []UniLingual `mkv:"ASPECT_RATIO"`
+	Cameras         []UniLingual `mkv:"CAMERA"`
+	Colors          []UniLingual `mkv:"COLOR"`
+	NegativeFormats []UniLingual `mkv:"FILM_NEGATIVE_FORMAT"`
+	Runtimes        []*Runtime   `mkv:"RUNTIME"`
+	SoundMixes      []UniLingual `mkv:"SOUND_MIX"`
+}
+
+func (r *TechSpecs) SetFieldCallback(name string, callback interface{}) {
+	if err := dynamic.SetStructFieldCallback(name, r, callback); err != nil {
+		global.Log.Error(fmt.Errorf("TechSpecs: Could not set field \"%s\": %s", name, err))
+	} else {
+		r.nonempty = true
 	}
-	//End String tag
+}
 
-	if err := writeTaggedFields(xw, r); err != nil {
-		return err
+func (r *TechSpecs) IsEmpty() bool {
+	return !r.nonempty
+}
+
+func (r *TechSpecs) CheckTag() error {
+	if r.IsEmpty() {
+		return fmt.Errorf("Technical specifications do not contain any payload")
 	}
+	return nil
+}
 
-	if err := xw.CloseElement(); err != nil {
-		return err
+func (r *TechSpecs) WriteTag(xw *ixml.XmlWriter, name string) error {
+	return writeNestedTag(xw, name, "", r)
+}
+
+// A song of the soundtrack, its credits are written as nested tags.
+type Song struct {
+	Title      string
+	Composers  []UniLingual `mkv:"COMPOSER"`
+	Performers []UniLingual `mkv:"PERFORMER"`
+	Writers    []UniLingual `mkv:"WRITTEN_BY"`
+}
+
+func (r *Song) CheckTag() error {
+	if len(r.Title) < 1 {
+		return fmt.Errorf("Missing song title")
+	}
+	return nil
+}
+
+func (r *Song) WriteTag(xw *ixml.XmlWriter, name string) error {
+	return writeNestedTag(xw, name, r.Title, r)
+}
+
+// An award or nomination, written as a tag containing the event and nested tags for the details.
+type Award struct {
+	Event      string
+	Category   UniLingual   `mkv:"CATEGORY"`
+	EventID    UniLingual   `mkv:"IMDB_EVENT_ID"`
+	Name       UniLingual   `mkv:"AWARD_NAME"`
+	Outcome    UniLingual   `mkv:"OUTCOME"`
+	Recipients []UniLingual `mkv:"RECIPIENT"`
+	Year       UniLingual   `mkv:"YEAR"`
+}
+
+func (r *Award) CheckTag() error {
+	if len(r.Event) < 1 {
+		return fmt.Errorf("Missing award event")
+	}
+	return nil
+}
+
+func (r *Award) WriteTag(xw *ixml.XmlWriter, name string) error {
+	return writeNestedTag(xw, name, r.Event, r)
+}
+
+// An amount of money, the currency is written as nested ISO 4217 code.
+type Money struct {
+	Amount   string
+	Currency UniLingual `mkv:"CURRENCY"`
+	Note     UniLingual `mkv:"NOTE"`
+}
+
+func (r *Money) CheckTag() error {
+	if len(r.Amount) < 1 {
+		return fmt.Errorf("Amount is empty")
+	}
+	return nil
+}
+
+func (r *Money) WriteTag(xw *ixml.XmlWriter, name string) error {
+	return writeNestedTag(xw, name, r.Amount, r)
+}
+
+// Box office figures of the movie.
+type BoxOffice struct {
+	nonempty       bool
+	Budget         *Money `mkv:"BUDGET"`
+	GrossDomestic  *Money `mkv:"GROSS_US_CANADA"`
+	GrossWorldwide *Money `mkv:"GROSS_WORLDWIDE"`
+	OpeningWeekend *Money `mkv:"OPENING_WEEKEND_US_CANADA"`
+}
+
+func (r *BoxOffice) SetFieldCallback(name string, callback interface{}) {
+	if err := dynamic.SetStructFieldCallback(name, r, callback); err != nil {
+		global.Log.Error(fmt.Errorf("BoxOffice: Could not set field \"%s\": %s", name, err))
+	} else {
+		r.nonempty = true
+	}
+}
+
+func (r *BoxOffice) IsEmpty() bool {
+	return !r.nonempty
+}
+
+func (r *BoxOffice) CheckTag() error {
+	if r.IsEmpty() {
+		return fmt.Errorf("Box office does not contain any payload")
+	}
+	return nil
+}
+
+func (r *BoxOffice) WriteTag(xw *ixml.XmlWriter, name string) error {
+	return writeNestedTag(xw, name, "", r)
+}
+
+// The collection a movie is part of, e.g. a film series.
+type Collection struct {
+	Title      UniLingual `mkv:"TITLE"`
+	TotalParts UniLingual `mkv:"TOTAL_PARTS"`
+}
+
+func (r *Collection) CheckTag() error {
+	if len(r.Title) < 1 {
+		return fmt.Errorf("Title is empty")
 	}
 	return nil
 }
 
+// Writes the collection as tag with TargetTypeValue 70.
+func (r *Collection) WriteTag(xw *ixml.XmlWriter) error {
+	if err := writeTagHeader(xw, "70"); err != nil {
+		return err
+	}
+	if err := writeTaggedFields(xw, r); err != nil {
+		return err
+	}
+	return xw.CloseElement()
+}
+
 type Movie struct {
-	Actors       []Actor        `mkv:"ACTOR"`
-	Countries    []*Country     `mkv:"COUNTRY"`
-	DateReleased UniLingual     `mkv:"DATE_RELEASED"`
-	DateTagged   UniLingual     `mkv:"DATE_TAGGED"`
-	Directors    []UniLingual   `mkv:"DIRECTOR"`
-	Genres       []MultiLingual `mkv:"GENRE"`
-	Imdb         UniLingual     `mkv:"IMDB"`
-	Keywords     []MultiLingual `mkv:"KEYWORDS"`
-	Producers    []UniLingual   `mkv:"PRODUCER"`
-	Synopses     []MultiLingual `mkv:"SYNOPSIS"`
-	Titles       []MultiLingual `mkv:"TITLE"`
-	Writers      []UniLingual   `mkv:"WRITTEN_BY"`
+	Actors                  []Actor        `mkv:"ACTOR"`
+	ArtDirectors            []*Person      `mkv:"ART_DIRECTOR"`
+	AssistantDirectors      []*Person      `mkv:"ASSISTANT_DIRECTOR"`
+	Awards                  []*Award       `mkv:"AWARD"`
+	BoxOffice               *BoxOffice     `mkv:"BOX_OFFICE"`
+	Cinematographers        []*Person      `mkv:"DIRECTOR_OF_PHOTOGRAPHY"`
+	Composers               []*Person      `mkv:"COMPOSER"`
+	CostumeDesigners        []*Person      `mkv:"COSTUME_DESIGNER"`
+	Countries               []*Country     `mkv:"COUNTRY"`
+	DateRecorded            UniLingual     `mkv:"DATE_RECORDED"`
+	DateReleased            UniLingual     `mkv:"DATE_RELEASED"`
+	DateTagged              UniLingual     `mkv:"DATE_TAGGED"`
+	Directors               []*Person      `mkv:"DIRECTOR"`
+	Distributors            []UniLingual   `mkv:"DISTRIBUTED_BY"`
+	Editors                 []*Person      `mkv:"EDITED_BY"`
+	Genres                  []MultiLingual `mkv:"GENRE"`
+	Imdb                    UniLingual     `mkv:"IMDB"`
+	Keywords                []MultiLingual `mkv:"KEYWORDS"`
+	Original                *Original      `mkv:"ORIGINAL"`
+	OtherCompanies          []UniLingual   `mkv:"OTHER_COMPANY"`
+	ParentalGuide           *ParentalGuide `mkv:"PARENTAL_GUIDE"`
+	PartNumber              UniLingual     `mkv:"PART_NUMBER"`
+	Producers               []*Person      `mkv:"PRODUCER"`
+	ProductionDesigners     []*Person      `mkv:"PRODUCTION_DESIGNER"`
+	ProductionStudios       []UniLingual   `mkv:"PRODUCTION_STUDIO"`
+	Ratings                 []*Rating      `mkv:"RATING"`
+	RecordingLocations      []UniLingual   `mkv:"RECORDING_LOCATION"`
+	Songs                   []*Song        `mkv:"SONG"`
+	SoundEngineers          []*Person      `mkv:"SOUND_ENGINEER"`
+	SpecialEffectsCompanies []UniLingual   `mkv:"SPECIAL_EFFECTS_COMPANY"`
+	Summaries               []MultiLingual `mkv:"SUMMARY"`
+	Synopses                []MultiLingual `mkv:"SYNOPSIS"`
+	TechSpecs               *TechSpecs     `mkv:"TECHNICAL_SPECS"`
+	Titles                  []MultiLingual `mkv:"TITLE"`
+	Writers                 []*Person      `mkv:"WRITTEN_BY"`
+	Taglines                []MultiLingual // Written with the tag name in TaglineTag
+	TaglineTag              string         // Tag name for taglines, defaults to DefaultTaglineTag
+	Interests               []MultiLingual // Written with the tag name in InterestTag
+	InterestTag             string         // Tag name for interests, must be set if there are interests
+	Collection              *Collection    // Written as separate tag with TargetTypeValue 70
 }
 
+// Tag name for taglines if Movie.TaglineTag is not set.
+const DefaultTaglineTag = "SUBTITLE"
+
 func (r *Movie) SetFieldCallback(name string, callback interface{}) {
 	if err := dynamic.SetStructFieldCallback(name, r, callback); err != nil {
 		global.Log.Error(fmt.Errorf("Movie: Could not set field \"%s\": %s", name, err))
 	}
 }
 
+// Returns the movie's country tag with the given name.
+// A new country tag will be appended if no such tag exists.
+func (r *Movie) Country(name string) *Country {
+	for _, country := range r.Countries {
+		if country.Name == name {
+			return country
+		}
+	}
+	country := &Country{Name: name}
+	r.Countries = append(r.Countries, country)
+	return country
+}
+
 func (r *Movie) CheckTag() error {
 	return nil
 }
 
 func (r *Movie) WriteTag(xw *ixml.XmlWriter) error {
 
-	//Write "Header" with TargetTypeValue=50
-	{
-		if err := xw.EncodeTokens(
-			ixml.NewStartElementSimple("Tag"), ixml.NewStartElementSimple("Targets"),
-			ixml.NewStartElementSimple("TargetTypeValue")); err != nil {
+	if r.Collection != nil {
+		if err := r.Collection.CheckTag(); err != nil {
+			global.Log.Debug(fmt.Sprintf("Movie: Did not write collection: %s", err))
+		} else if err := r.Collection.WriteTag(xw); err != nil {
 			return err
 		}
-		if err := xw.WriteText([]byte("50")); err != nil {
-			return err
+	}
+
+	if err := writeTagHeader(xw, "50"); err != nil {
+		return err
+	}
+
+	if err := writeTaggedFields(xw, r); err != nil {
+		return err
+	}
+
+	taglineTag := r.TaglineTag
+	if taglineTag == "" {
+		taglineTag = DefaultTaglineTag
+	}
+	for i := range r.Taglines {
+		if err := r.Taglines[i].CheckTag(); err != nil {
+			global.Log.Debug(fmt.Sprintf("Movie: Did not write tagline: %s", err))
+			continue
 		}
-		if err := xw.CloseElement(); err != nil {
+		if err := r.Taglines[i].WriteTag(xw, taglineTag); err != nil {
 			return err
 		}
-		if err := xw.CloseElement(); err != nil {
+	}
+
+	for i := range r.Interests {
+		if err := r.Interests[i].CheckTag(); err != nil {
+			global.Log.Debug(fmt.Sprintf("Movie: Did not write interest: %s", err))
+			continue
+		}
+		if err := r.Interests[i].WriteTag(xw, r.InterestTag); err != nil {
 			return err
 		}
 	}
 
-	if err := writeTaggedFields(xw, r); err != nil {
+	if err := xw.CloseElement(); err != nil {
 		return err
 	}
 
+	return nil
+}
+
+// Opens a Tag element and writes its Targets element with the given TargetTypeValue.
+// The Tag element is left open for the caller to write its Simple tags.
+func writeTagHeader(xw *ixml.XmlWriter, targetTypeValue string) error {
+	if err := xw.EncodeTokens(
+		ixml.NewStartElementSimple("Tag"), ixml.NewStartElementSimple("Targets"),
+		ixml.NewStartElementSimple("TargetTypeValue")); err != nil {
+		return err
+	}
+	if err := xw.WriteText([]byte(targetTypeValue)); err != nil {
+		return err
+	}
 	if err := xw.CloseElement(); err != nil {
 		return err
 	}
-
-	return nil
+	return xw.CloseElement()
 }
 
-// Writes the matroska tags as an xml file
-func WriteTags(w io.Writer, tagWriter func(*ixml.XmlWriter) error) error {
+// Writes the Tags element, tagWriter may write any number of Tag elements into it.
+// Multilingual tags carry their language in the elements selected by mode.
+func WriteTags(w io.Writer, mode LanguageMode, tagWriter func(*ixml.XmlWriter) error) error {
 	xw := ixml.NewXmlWriter(w)
 	xw.Indent("", "\t")
+	xw.SetValue(languageModeKey{}, mode)
 	if err := xw.EncodeToken(xml.ProcInst{Target: "xml", Inst: []byte(`version="1.0" encoding="UTF-8"`)}); err != nil {
 		return err
 	}
@@ -241,9 +633,26 @@ func WriteTags(w io.Writer, tagWriter func(*ixml.XmlWriter) error) error {
 	return nil
 }
 
+// Writes a simple tag with the given name and text that contains the tagged fields of rec as nested tags.
+// The String element will be omitted if text is empty.
+func writeNestedTag(xw *ixml.XmlWriter, name, text string, rec interface{}) error {
+	subtags := make([][2]string, 0, 2)
+	subtags = append(subtags, [2]string{"Name", name})
+	if text != "" {
+		subtags = append(subtags, [2]string{"String", text})
+	}
+	return ixml.WriteTagWithSubtags(xw, "Simple", subtags, func(xw *ixml.XmlWriter) error {
+		return writeTaggedFields(xw, rec)
+	})
+}
+
 func writeTaggedFields(xw *ixml.XmlWriter, rec interface{}) error {
 
 	write := func(field reflect.Value, tag string) error {
+		if field.Kind() == reflect.Ptr && field.IsNil() {
+			global.Log.Debug(fmt.Sprintf("writeTaggedFields: Skipping nil tag \"%s\"", tag))
+			return nil
+		}
 		i := field.Interface()
 		if tw, ok := i.(TagWriter); ok {
 			if err := tw.CheckTag(); err != nil {
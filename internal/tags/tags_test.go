@@ -0,0 +1,62 @@
+//This file is part of imdb2mkvtags ©2026 Jörg Walter
+
+package tags
+
+import (
+	"bytes"
+	ixml "github.com/jwdev42/imdb2mkvtags/internal/xml"
+	"strings"
+	"testing"
+)
+
+func TestNewRating(t *testing.T) {
+	tests := []struct {
+		source      string
+		value, best float64
+		votes       int
+		expected    Rating
+	}{
+		{"IMDb", 7.5, 10, 432109, Rating{Normalized: "3.75", Best: "10", Source: "IMDb", Value: "7.5", Votes: "432109"}},
+		{"Metacritic", 69, 100, 0, Rating{Normalized: "3.45", Best: "100", Source: "Metacritic", Value: "69"}},
+		{"IMDb", 8.3, 10, -1, Rating{Normalized: "4.15", Best: "10", Source: "IMDb", Value: "8.3"}},
+		{"IMDb", 6.7, 10, 1, Rating{Normalized: "3.35", Best: "10", Source: "IMDb", Value: "6.7", Votes: "1"}},
+		{"Letterboxd", 3.333, 5, 12, Rating{Normalized: "3.33", Best: "5", Source: "Letterboxd", Value: "3.333", Votes: "12"}},
+	}
+	for _, test := range tests {
+		if res := NewRating(test.source, test.value, test.best, test.votes); *res != test.expected {
+			t.Errorf("NewRating(%q, %v, %v, %d): Expected %+v, got %+v", test.source, test.value, test.best, test.votes, test.expected, *res)
+		}
+	}
+}
+
+func TestLanguageMode(t *testing.T) {
+	tests := []struct {
+		mode       LanguageMode
+		lang       string
+		expected   []string
+		unexpected []string
+	}{
+		{LanguageIETF, "de-DE", []string{"<TagLanguageIETF>de-DE</TagLanguageIETF>"}, []string{"<TagLanguage>"}},
+		{LanguageBoth, "de-DE", []string{"<TagLanguage>ger</TagLanguage>", "<TagLanguageIETF>de-DE</TagLanguageIETF>"}, nil},
+		{LanguageBoth, "yue", []string{"<TagLanguageIETF>yue</TagLanguageIETF>"}, []string{"<TagLanguage>"}},
+		{LanguageLegacy, "de-DE", []string{"<TagLanguage>ger</TagLanguage>"}, []string{"<TagLanguageIETF>"}},
+		{LanguageLegacy, "yue", []string{"<TagLanguage>und</TagLanguage>"}, []string{"<TagLanguageIETF>"}},
+	}
+	for _, test := range tests {
+		var b bytes.Buffer
+		tag := &MultiLingual{Text: "Batman", Lang: test.lang}
+		if err := WriteTags(&b, test.mode, func(xw *ixml.XmlWriter) error { return tag.WriteTag(xw, "TITLE") }); err != nil {
+			t.Fatal(err)
+		}
+		for _, element := range test.expected {
+			if !strings.Contains(b.String(), element) {
+				t.Errorf("WriteTags(%s, %q): Expected %s in %q", test.mode.String(), test.lang, element, b.String())
+			}
+		}
+		for _, element := range test.unexpected {
+			if strings.Contains(b.String(), element) {
+				t.Errorf("WriteTags(%s, %q): Unexpected %s in %q", test.mode.String(), test.lang, element, b.String())
+			}
+		}
+	}
+}
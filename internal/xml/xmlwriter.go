@@ -37,7 +37,8 @@ func (r *endStack) pop() xml.EndElement {
 
 type XmlWriter struct {
 	*xml.Encoder
-	s endStack
+	s      endStack
+	values map[any]any
 }
 
 func NewXmlWriter(w io.Writer) *XmlWriter {
@@ -88,6 +89,19 @@ func (r *XmlWriter) CloseAllElements() error {
 	return nil
 }
 
+// Stores a value under key for the writers of the document's elements.
+func (r *XmlWriter) SetValue(key, value any) {
+	if r.values == nil {
+		r.values = make(map[any]any)
+	}
+	r.values[key] = value
+}
+
+// Returns the value stored under key or nil if no value is stored.
+func (r *XmlWriter) Value(key any) any {
+	return r.values[key]
+}
+
 func (r *XmlWriter) OpenElements() int {
 	return r.s.elements
 }